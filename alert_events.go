@@ -0,0 +1,52 @@
+package incident
+
+import (
+	"context"
+	"fmt"
+)
+
+// AlertEventsService handles communication with the alert events related
+// methods of the Incident.io API.
+//
+// Alert events are sent to an HTTP alert source, which creates, updates
+// or resolves alerts in incident.io.
+//
+// API docs: https://api-docs.incident.io/tag/Alert-Events-V2
+type AlertEventsService service
+
+// Create sends an alert event to the HTTP alert source alertSourceConfigID.
+//
+// HTTP alert sources authenticate with their own token, shown in the
+// incident.io dashboard when configuring the alert source. It replaces
+// the API key of the client for this request. If token is empty, the
+// API key of the client is used.
+//
+// Events with the same deduplication key refer to the same alert, so a
+// resolved event resolves the alert raised by a previous firing event.
+//
+// API docs: https://api-docs.incident.io/tag/Alert-Events-V2#operation/Alert%20Events%20V2_CreateHTTP
+func (s *AlertEventsService) Create(ctx context.Context, alertSourceConfigID, token string, event *AlertEvent) (*AlertEventResponse, *Response, error) {
+	u := apiV2Path(fmt.Sprintf("alert_events/http/%s", alertSourceConfigID))
+
+	req, err := s.client.NewRequest("POST", u, event)
+	if err != nil {
+		return nil, nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	// Sending the same event twice updates the alert of its deduplication
+	// key to the same state, which makes this request safe to retry.
+	// Without a key, every event raises a new alert.
+	if event != nil && event.DeduplicationKey != "" {
+		req = MarkRetryable(req)
+	}
+
+	v := &AlertEventResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
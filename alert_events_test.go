@@ -0,0 +1,36 @@
+package incident
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestAlertEventsService_Create_Retry(t *testing.T) {
+	tests := []struct {
+		name         string
+		dedupKey     string
+		wantRequests int
+	}{
+		{name: "with deduplication key", dedupKey: "disk-full-db-1", wantRequests: 2},
+		{name: "without deduplication key", wantRequests: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &retryServer{statuses: []int{http.StatusServiceUnavailable}}
+			c := newRetryTestClient(t, s)
+
+			event := &AlertEvent{Title: "Disk full", Status: AlertEventStatusFiring, DeduplicationKey: tt.dedupKey}
+			c.AlertEvents.Create(context.Background(), "config-id", "token", event)
+
+			if len(s.bodies) != tt.wantRequests {
+				t.Errorf("server received %d requests, want %d", len(s.bodies), tt.wantRequests)
+			}
+			for i, h := range s.headers {
+				if v := h.Get("Idempotency-Key"); v != "" {
+					t.Errorf("attempt %d sent Idempotency-Key header %q, want none", i+1, v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package incident
+
+import (
+	"context"
+	"fmt"
+)
+
+// AlertSourcesService handles communication with the alert source related
+// methods of the Incident.io API.
+//
+// API docs: https://api-docs.incident.io/tag/Alert-Sources-V2
+type AlertSourcesService service
+
+// List list all alert sources for an organisation.
+//
+// API docs: https://api-docs.incident.io/tag/Alert-Sources-V2#operation/Alert%20Sources%20V2_List
+func (s *AlertSourcesService) List(ctx context.Context) (*AlertSourcesList, *Response, error) {
+	u := apiV2Path("alert_sources")
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &AlertSourcesList{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Get returns a single alert source.
+//
+// id represents the unique identifier for the alert source
+//
+// API docs: https://api-docs.incident.io/tag/Alert-Sources-V2#operation/Alert%20Sources%20V2_Show
+func (s *AlertSourcesService) Get(ctx context.Context, id string) (*AlertSourceResponse, *Response, error) {
+	u := apiV2Path(fmt.Sprintf("alert_sources/%s", id))
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &AlertSourceResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
@@ -0,0 +1,41 @@
+package incident
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestAlertSourcesService(t *testing.T) {
+	ctx := context.Background()
+	want := AlertSource{ID: "src_1", Name: "Prometheus", SourceType: "prometheus"}
+
+	var path string
+	var query url.Values
+	c := newAlertsTestClient(t, `{"alert_sources":[{"id":"src_1","name":"Prometheus","source_type":"prometheus"}]}`, &path, &query)
+
+	list, _, err := c.AlertSources.List(ctx)
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if path != "/v2/alert_sources" {
+		t.Errorf("List requested %s, want /v2/alert_sources", path)
+	}
+	if !reflect.DeepEqual(list.AlertSources, []AlertSource{want}) {
+		t.Errorf("List returned %+v, want [%+v]", list.AlertSources, want)
+	}
+
+	c = newAlertsTestClient(t, `{"alert_source":{"id":"src_1","name":"Prometheus","source_type":"prometheus"}}`, &path, &query)
+
+	v, _, err := c.AlertSources.Get(ctx, "src_1")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if path != "/v2/alert_sources/src_1" {
+		t.Errorf("Get requested %s, want /v2/alert_sources/src_1", path)
+	}
+	if v.AlertSource != want {
+		t.Errorf("Get returned %+v, want %+v", v.AlertSource, want)
+	}
+}
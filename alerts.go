@@ -0,0 +1,221 @@
+package incident
+
+import (
+	"context"
+	"fmt"
+)
+
+// AlertsService handles communication with the alert related
+// methods of the Incident.io API.
+//
+// API docs: https://api-docs.incident.io/tag/Alerts-V2
+type AlertsService service
+
+// List list all alerts for an organisation.
+//
+// API docs: https://api-docs.incident.io/tag/Alerts-V2#operation/Alerts%20V2_List
+func (s *AlertsService) List(ctx context.Context, opts *AlertsListOptions) (*AlertsList, *Response, error) {
+	u := apiV2Path("alerts")
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &AlertsList{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Iter returns an iterator over all alerts matching opts.
+// The iterator follows the After cursor of the API and fetches one page
+// at a time. opts is copied and not modified by the iterator.
+func (s *AlertsService) Iter(opts *AlertsListOptions) *AlertsIterator {
+	return NewAlertsIterator(s, opts)
+}
+
+// ListAll calls fn for every alert matching opts, following all pages.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func (s *AlertsService) ListAll(ctx context.Context, opts *AlertsListOptions, fn func(Alert) error) error {
+	return ListAllAlerts(ctx, s, opts, fn)
+}
+
+// AlertsIterator iterates over the pages of AlertsService.List.
+// Create one with AlertsService.Iter.
+type AlertsIterator struct {
+	pager *cursorPager[Alert]
+}
+
+// NewAlertsIterator returns an iterator over all alerts matching opts,
+// that fetches the pages with api.List.
+// This is useful to iterate over alerts of a mock AlertsAPI.
+// opts is copied and not modified by the iterator.
+func NewAlertsIterator(api AlertsAPI, opts *AlertsListOptions) *AlertsIterator {
+	o := AlertsListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	fetch := func(ctx context.Context, after string) ([]Alert, *PaginationMeta, error) {
+		o.After = after
+		list, _, err := api.List(ctx, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+		return list.Alerts, list.PaginationMeta, nil
+	}
+	id := func(v Alert) string { return v.ID }
+
+	return &AlertsIterator{pager: newCursorPager(o.After, fetch, id)}
+}
+
+// ListAllAlerts calls fn for every alert matching opts, following all pages
+// fetched with api.List.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func ListAllAlerts(ctx context.Context, api AlertsAPI, opts *AlertsListOptions, fn func(Alert) error) error {
+	return forEach(ctx, NewAlertsIterator(api, opts).pager, fn)
+}
+
+// Next advances the iterator to the next alert, fetching the next page
+// if required. It returns false when there are no more alerts or an error
+// occurred. Check Err after Next returned false.
+func (it *AlertsIterator) Next(ctx context.Context) bool {
+	return it.pager.next(ctx)
+}
+
+// Alert returns the current alert.
+// It is only valid after a call to Next returned true.
+func (it *AlertsIterator) Alert() Alert {
+	return it.pager.current
+}
+
+// Err returns the first error that occurred during iteration, if any.
+func (it *AlertsIterator) Err() error {
+	return it.pager.err
+}
+
+// Get returns a single alert.
+//
+// id represents the unique identifier for the alert
+//
+// API docs: https://api-docs.incident.io/tag/Alerts-V2#operation/Alerts%20V2_Show
+func (s *AlertsService) Get(ctx context.Context, id string) (*AlertResponse, *Response, error) {
+	u := apiV2Path(fmt.Sprintf("alerts/%s", id))
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &AlertResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// ListIncidentAlerts list the connections between alerts and incidents,
+// e.g. to find the incidents an alert was attached to.
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Alerts-V2#operation/Incident%20Alerts%20V2_List
+func (s *AlertsService) ListIncidentAlerts(ctx context.Context, opts *IncidentAlertsListOptions) (*IncidentAlertsList, *Response, error) {
+	u := apiV2Path("incident_alerts")
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentAlertsList{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// IterIncidentAlerts returns an iterator over all incident alerts matching opts.
+// The iterator follows the After cursor of the API and fetches one page
+// at a time. opts is copied and not modified by the iterator.
+func (s *AlertsService) IterIncidentAlerts(opts *IncidentAlertsListOptions) *IncidentAlertsIterator {
+	return NewIncidentAlertsIterator(s, opts)
+}
+
+// ListAllIncidentAlerts calls fn for every incident alert matching opts, following all pages.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func (s *AlertsService) ListAllIncidentAlerts(ctx context.Context, opts *IncidentAlertsListOptions, fn func(IncidentAlert) error) error {
+	return ListAllIncidentAlerts(ctx, s, opts, fn)
+}
+
+// IncidentAlertsIterator iterates over the pages of AlertsService.ListIncidentAlerts.
+// Create one with AlertsService.IterIncidentAlerts.
+type IncidentAlertsIterator struct {
+	pager *cursorPager[IncidentAlert]
+}
+
+// NewIncidentAlertsIterator returns an iterator over all incident alerts matching opts,
+// that fetches the pages with api.ListIncidentAlerts.
+// This is useful to iterate over incident alerts of a mock AlertsAPI.
+// opts is copied and not modified by the iterator.
+func NewIncidentAlertsIterator(api AlertsAPI, opts *IncidentAlertsListOptions) *IncidentAlertsIterator {
+	o := IncidentAlertsListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	fetch := func(ctx context.Context, after string) ([]IncidentAlert, *PaginationMeta, error) {
+		o.After = after
+		list, _, err := api.ListIncidentAlerts(ctx, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+		return list.IncidentAlerts, list.PaginationMeta, nil
+	}
+	id := func(v IncidentAlert) string { return v.ID }
+
+	return &IncidentAlertsIterator{pager: newCursorPager(o.After, fetch, id)}
+}
+
+// ListAllIncidentAlerts calls fn for every incident alert matching opts, following all pages
+// fetched with api.ListIncidentAlerts.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func ListAllIncidentAlerts(ctx context.Context, api AlertsAPI, opts *IncidentAlertsListOptions, fn func(IncidentAlert) error) error {
+	return forEach(ctx, NewIncidentAlertsIterator(api, opts).pager, fn)
+}
+
+// Next advances the iterator to the next incident alert, fetching the next page
+// if required. It returns false when there are no more incident alerts or an error
+// occurred. Check Err after Next returned false.
+func (it *IncidentAlertsIterator) Next(ctx context.Context) bool {
+	return it.pager.next(ctx)
+}
+
+// IncidentAlert returns the current incident alert.
+// It is only valid after a call to Next returned true.
+func (it *IncidentAlertsIterator) IncidentAlert() IncidentAlert {
+	return it.pager.current
+}
+
+// Err returns the first error that occurred during iteration, if any.
+func (it *IncidentAlertsIterator) Err() error {
+	return it.pager.err
+}
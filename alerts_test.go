@@ -0,0 +1,154 @@
+package incident
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newAlertsTestClient returns a client for a server that records the path
+// and query of every request and responds with body.
+func newAlertsTestClient(t *testing.T, body string, path *string, query *url.Values) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("sent %s, want GET", r.Method)
+		}
+		*path, *query = r.URL.Path, r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+	return c
+}
+
+func TestAlertsService_List(t *testing.T) {
+	const body = `{"alerts":[{"id":"a1","alert_source_id":"src_1","title":"CPU high","status":"firing","created_at":"2024-03-01T10:00:00Z"}],"pagination_meta":{"after":"a1","page_size":25}}`
+	after := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, 3, 2, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		opts      *AlertsListOptions
+		wantQuery url.Values
+	}{
+		{
+			name:      "nil options",
+			opts:      nil,
+			wantQuery: url.Values{},
+		},
+		{
+			name:      "zero times are omitted",
+			opts:      &AlertsListOptions{PageSize: 25},
+			wantQuery: url.Values{"page_size": {"25"}},
+		},
+		{
+			name: "all filters",
+			opts: &AlertsListOptions{
+				PageSize:         25,
+				After:            "a0",
+				Status:           []string{AlertStatusFiring, AlertStatusResolved},
+				AlertSourceID:    []string{"src_1"},
+				DeduplicationKey: "cpu-high",
+				CreatedAfter:     after,
+				CreatedBefore:    before,
+			},
+			wantQuery: url.Values{
+				"page_size":               {"25"},
+				"after":                   {"a0"},
+				"status[one_of]":          {"firing", "resolved"},
+				"alert_source_id[one_of]": {"src_1"},
+				"deduplication_key[is]":   {"cpu-high"},
+				"created_at[gte]":         {"2024-03-01T00:00:00Z"},
+				"created_at[lte]":         {"2024-03-02T12:30:00Z"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var path string
+			var query url.Values
+			c := newAlertsTestClient(t, body, &path, &query)
+
+			list, _, err := c.Alerts.List(context.Background(), tt.opts)
+			if err != nil {
+				t.Fatalf("List returned error: %v", err)
+			}
+			if path != "/v2/alerts" {
+				t.Errorf("List requested %s, want /v2/alerts", path)
+			}
+			if !reflect.DeepEqual(query, tt.wantQuery) {
+				t.Errorf("List sent query %v, want %v", query, tt.wantQuery)
+			}
+
+			want := []Alert{{
+				ID:            "a1",
+				AlertSourceID: "src_1",
+				Title:         "CPU high",
+				Status:        AlertStatusFiring,
+				CreatedAt:     time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+			}}
+			if !reflect.DeepEqual(list.Alerts, want) {
+				t.Errorf("List returned %+v, want %+v", list.Alerts, want)
+			}
+			if list.PaginationMeta == nil || list.PaginationMeta.After != "a1" {
+				t.Errorf("List returned pagination meta %+v, want after a1", list.PaginationMeta)
+			}
+		})
+	}
+}
+
+func TestAlertsService_Get(t *testing.T) {
+	var path string
+	var query url.Values
+	c := newAlertsTestClient(t, `{"alert":{"id":"a1","title":"CPU high","status":"resolved","resolved_at":"2024-03-01T11:00:00Z"}}`, &path, &query)
+
+	v, _, err := c.Alerts.Get(context.Background(), "a1")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if path != "/v2/alerts/a1" {
+		t.Errorf("Get requested %s, want /v2/alerts/a1", path)
+	}
+	if v.Alert.ID != "a1" || v.Alert.Status != AlertStatusResolved {
+		t.Errorf("Get returned %+v, want resolved alert a1", v.Alert)
+	}
+	if v.Alert.ResolvedAt == nil || !v.Alert.ResolvedAt.Equal(time.Date(2024, 3, 1, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("Get returned resolved_at %v, want 2024-03-01T11:00:00Z", v.Alert.ResolvedAt)
+	}
+}
+
+func TestAlertsService_ListIncidentAlerts(t *testing.T) {
+	var path string
+	var query url.Values
+	c := newAlertsTestClient(t, `{"incident_alerts":[{"id":"ia_1","alert":{"id":"a1","title":"CPU high"},"incident":{"id":"inc_1","name":"Checkout down","reference":"INC-1","visibility":"public"}}]}`, &path, &query)
+
+	list, _, err := c.Alerts.ListIncidentAlerts(context.Background(), &IncidentAlertsListOptions{PageSize: 10, AlertID: "a1", IncidentID: "inc_1"})
+	if err != nil {
+		t.Fatalf("ListIncidentAlerts returned error: %v", err)
+	}
+	if path != "/v2/incident_alerts" {
+		t.Errorf("ListIncidentAlerts requested %s, want /v2/incident_alerts", path)
+	}
+	wantQuery := url.Values{"page_size": {"10"}, "alert_id": {"a1"}, "incident_id": {"inc_1"}}
+	if !reflect.DeepEqual(query, wantQuery) {
+		t.Errorf("ListIncidentAlerts sent query %v, want %v", query, wantQuery)
+	}
+
+	want := []IncidentAlert{{
+		ID:       "ia_1",
+		Alert:    Alert{ID: "a1", Title: "CPU high"},
+		Incident: IncidentAlertIncident{ID: "inc_1", Name: "Checkout down", Reference: "INC-1", Visibility: "public"},
+	}}
+	if !reflect.DeepEqual(list.IncidentAlerts, want) {
+		t.Errorf("ListIncidentAlerts returned %+v, want %+v", list.IncidentAlerts, want)
+	}
+}
@@ -0,0 +1,313 @@
+package incident
+
+import (
+	"context"
+	"fmt"
+)
+
+// CatalogService handles communication with the catalog related
+// methods of the Incident.io API.
+//
+// The catalog consists of catalog types (e.g. "Service" or "Team"),
+// which define a schema of attributes, and catalog entries of these types.
+//
+// API docs: https://api-docs.incident.io/tag/Catalog-V2
+type CatalogService service
+
+// ListTypes list all catalog types for an organisation.
+//
+// API docs: https://api-docs.incident.io/tag/Catalog-V2#operation/Catalog%20V2_ListTypes
+func (s *CatalogService) ListTypes(ctx context.Context) (*CatalogTypesList, *Response, error) {
+	u := apiV2Path("catalog_types")
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CatalogTypesList{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// GetType returns a single catalog type.
+//
+// id represents the unique identifier for the catalog type
+//
+// API docs: https://api-docs.incident.io/tag/Catalog-V2#operation/Catalog%20V2_ShowType
+func (s *CatalogService) GetType(ctx context.Context, id string) (*CatalogTypeResponse, *Response, error) {
+	u := apiV2Path(fmt.Sprintf("catalog_types/%s", id))
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CatalogTypeResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// CreateType creates a new catalog type.
+// The attributes of the type are set afterwards with UpdateTypeSchema.
+//
+// API docs: https://api-docs.incident.io/tag/Catalog-V2#operation/Catalog%20V2_CreateType
+func (s *CatalogService) CreateType(ctx context.Context, opts *CreateCatalogTypeRequest) (*CatalogTypeResponse, *Response, error) {
+	u := apiV2Path("catalog_types")
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CatalogTypeResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// UpdateType updates an existing catalog type.
+//
+// id represents the unique identifier for the catalog type
+//
+// API docs: https://api-docs.incident.io/tag/Catalog-V2#operation/Catalog%20V2_UpdateType
+func (s *CatalogService) UpdateType(ctx context.Context, id string, opts *UpdateCatalogTypeRequest) (*CatalogTypeResponse, *Response, error) {
+	u := apiV2Path(fmt.Sprintf("catalog_types/%s", id))
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CatalogTypeResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// UpdateTypeSchema updates the schema attributes of an existing catalog type.
+//
+// id represents the unique identifier for the catalog type
+//
+// API docs: https://api-docs.incident.io/tag/Catalog-V2#operation/Catalog%20V2_UpdateTypeSchema
+func (s *CatalogService) UpdateTypeSchema(ctx context.Context, id string, opts *UpdateCatalogTypeSchemaRequest) (*CatalogTypeResponse, *Response, error) {
+	u := apiV2Path(fmt.Sprintf("catalog_types/%s/actions/update_schema", id))
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CatalogTypeResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// DeleteType archives a catalog type and all of its entries.
+//
+// id represents the unique identifier for the catalog type
+//
+// API docs: https://api-docs.incident.io/tag/Catalog-V2#operation/Catalog%20V2_DestroyType
+func (s *CatalogService) DeleteType(ctx context.Context, id string) (*Response, error) {
+	u := apiV2Path(fmt.Sprintf("catalog_types/%s", id))
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListEntries list catalog entries of a catalog type.
+// The catalog type ID of opts is required.
+//
+// API docs: https://api-docs.incident.io/tag/Catalog-V2#operation/Catalog%20V2_ListEntries
+func (s *CatalogService) ListEntries(ctx context.Context, opts *CatalogEntriesListOptions) (*CatalogEntriesList, *Response, error) {
+	u := apiV2Path("catalog_entries")
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CatalogEntriesList{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// IterEntries returns an iterator over all catalog entries matching opts.
+// The iterator follows the After cursor of the API and fetches one page
+// at a time. opts is copied and not modified by the iterator.
+func (s *CatalogService) IterEntries(opts *CatalogEntriesListOptions) *CatalogEntriesIterator {
+	return NewCatalogEntriesIterator(s, opts)
+}
+
+// ListAllEntries calls fn for every catalog entry matching opts, following all pages.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func (s *CatalogService) ListAllEntries(ctx context.Context, opts *CatalogEntriesListOptions, fn func(CatalogEntry) error) error {
+	return ListAllCatalogEntries(ctx, s, opts, fn)
+}
+
+// CatalogEntriesIterator iterates over the pages of CatalogService.ListEntries.
+// Create one with CatalogService.IterEntries.
+type CatalogEntriesIterator struct {
+	pager *cursorPager[CatalogEntry]
+}
+
+// NewCatalogEntriesIterator returns an iterator over all catalog entries matching opts,
+// that fetches the pages with api.ListEntries.
+// This is useful to iterate over catalog entries of a mock CatalogAPI.
+// opts is copied and not modified by the iterator.
+func NewCatalogEntriesIterator(api CatalogAPI, opts *CatalogEntriesListOptions) *CatalogEntriesIterator {
+	o := CatalogEntriesListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	fetch := func(ctx context.Context, after string) ([]CatalogEntry, *PaginationMeta, error) {
+		o.After = after
+		list, _, err := api.ListEntries(ctx, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+		return list.CatalogEntries, list.PaginationMeta, nil
+	}
+	id := func(v CatalogEntry) string { return v.ID }
+
+	return &CatalogEntriesIterator{pager: newCursorPager(o.After, fetch, id)}
+}
+
+// ListAllCatalogEntries calls fn for every catalog entry matching opts, following all pages
+// fetched with api.ListEntries.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func ListAllCatalogEntries(ctx context.Context, api CatalogAPI, opts *CatalogEntriesListOptions, fn func(CatalogEntry) error) error {
+	return forEach(ctx, NewCatalogEntriesIterator(api, opts).pager, fn)
+}
+
+// Next advances the iterator to the next catalog entry, fetching the next page
+// if required. It returns false when there are no more catalog entries or an error
+// occurred. Check Err after Next returned false.
+func (it *CatalogEntriesIterator) Next(ctx context.Context) bool {
+	return it.pager.next(ctx)
+}
+
+// CatalogEntry returns the current catalog entry.
+// It is only valid after a call to Next returned true.
+func (it *CatalogEntriesIterator) CatalogEntry() CatalogEntry {
+	return it.pager.current
+}
+
+// Err returns the first error that occurred during iteration, if any.
+func (it *CatalogEntriesIterator) Err() error {
+	return it.pager.err
+}
+
+// GetEntry returns a single catalog entry.
+//
+// id represents the unique identifier for the catalog entry
+//
+// API docs: https://api-docs.incident.io/tag/Catalog-V2#operation/Catalog%20V2_ShowEntry
+func (s *CatalogService) GetEntry(ctx context.Context, id string) (*CatalogEntryResponse, *Response, error) {
+	u := apiV2Path(fmt.Sprintf("catalog_entries/%s", id))
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CatalogEntryResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// CreateEntry creates a new catalog entry.
+//
+// API docs: https://api-docs.incident.io/tag/Catalog-V2#operation/Catalog%20V2_CreateEntry
+func (s *CatalogService) CreateEntry(ctx context.Context, opts *CreateCatalogEntryRequest) (*CatalogEntryResponse, *Response, error) {
+	u := apiV2Path("catalog_entries")
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CatalogEntryResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// UpdateEntry updates an existing catalog entry.
+// The entry is replaced with opts, fields not set are cleared.
+//
+// id represents the unique identifier for the catalog entry
+//
+// API docs: https://api-docs.incident.io/tag/Catalog-V2#operation/Catalog%20V2_UpdateEntry
+func (s *CatalogService) UpdateEntry(ctx context.Context, id string, opts *UpdateCatalogEntryRequest) (*CatalogEntryResponse, *Response, error) {
+	u := apiV2Path(fmt.Sprintf("catalog_entries/%s", id))
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CatalogEntryResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// DeleteEntry archives a catalog entry.
+//
+// id represents the unique identifier for the catalog entry
+//
+// API docs: https://api-docs.incident.io/tag/Catalog-V2#operation/Catalog%20V2_DestroyEntry
+func (s *CatalogService) DeleteEntry(ctx context.Context, id string) (*Response, error) {
+	u := apiV2Path(fmt.Sprintf("catalog_entries/%s", id))
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
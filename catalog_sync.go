@@ -0,0 +1,372 @@
+package incident
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// catalogSyncPageSize is the page size used to list the existing entries of a catalog type.
+const catalogSyncPageSize = 250
+
+// DesiredCatalogEntry describes a catalog entry as it should exist
+// after a catalog sync.
+//
+// Entries are matched with existing entries by their external ID first,
+// and by their aliases second. At least one of both must be set.
+type DesiredCatalogEntry struct {
+	// Human readable name of the catalog entry
+	Name string
+
+	// External identifier from the source system, e.g. the key of the entry in a YAML file.
+	// If empty, the external ID of a matched existing entry is kept.
+	ExternalID string
+
+	// Alternative names that can be used to refer to this entry
+	Aliases []string
+
+	// When the catalog type is ranked, this is used to help order things
+	Rank int32
+
+	// Values of this entry, by ID of the schema attribute.
+	// Only the attributes listed here are compared and updated,
+	// other attributes of existing entries are left untouched.
+	AttributeValues map[string]CatalogAttributeBindingPayload
+}
+
+// CatalogSyncUpdate is an existing catalog entry that differs from
+// its desired state.
+type CatalogSyncUpdate struct {
+	// Entry as it currently exists
+	Current CatalogEntry
+
+	// Entry as it should exist
+	Desired DesiredCatalogEntry
+}
+
+// CatalogSyncPlan lists the changes required to bring the entries of a
+// catalog type into their desired state.
+type CatalogSyncPlan struct {
+	// ID of the catalog type the plan applies to
+	CatalogTypeID string
+
+	// Entries that don't exist yet
+	Create []DesiredCatalogEntry
+
+	// Entries that exist, but differ from their desired state
+	Update []CatalogSyncUpdate
+
+	// Entries that exist, but are not desired.
+	// Only populated if pruning is enabled.
+	Delete []CatalogEntry
+
+	// Number of entries that are already in their desired state
+	Unchanged int
+}
+
+// IsEmpty reports whether the plan contains no changes.
+func (p *CatalogSyncPlan) IsEmpty() bool {
+	return len(p.Create) == 0 && len(p.Update) == 0 && len(p.Delete) == 0
+}
+
+// CatalogSyncOptions defines parameters for SyncCatalogEntries.
+type CatalogSyncOptions struct {
+	// Only compute the plan, without changing anything.
+	DryRun bool
+
+	// Delete existing entries of the catalog type that are not desired.
+	// Without Prune, such entries are left untouched.
+	Prune bool
+}
+
+// SyncCatalogEntries reconciles the entries of a catalog type with the
+// desired entries: Missing entries are created, differing entries are
+// updated and, if opts.Prune is set, entries that are not desired are deleted.
+//
+// The executed plan is returned. With opts.DryRun, the plan is only
+// computed and returned, but not executed.
+func SyncCatalogEntries(ctx context.Context, catalog CatalogAPI, catalogTypeID string, desired []DesiredCatalogEntry, opts *CatalogSyncOptions) (*CatalogSyncPlan, error) {
+	if opts == nil {
+		opts = &CatalogSyncOptions{}
+	}
+
+	plan, err := PlanCatalogSync(ctx, catalog, catalogTypeID, desired, opts.Prune)
+	if err != nil {
+		return nil, err
+	}
+	if opts.DryRun {
+		return plan, nil
+	}
+	return plan, plan.Apply(ctx, catalog)
+}
+
+// PlanCatalogSync computes the changes required to bring the entries of a
+// catalog type into their desired state, without changing anything.
+// The existing entries are listed with catalog.ListEntries.
+// Existing entries that are not desired are only planned for deletion if
+// prune is set.
+func PlanCatalogSync(ctx context.Context, catalog CatalogAPI, catalogTypeID string, desired []DesiredCatalogEntry, prune bool) (*CatalogSyncPlan, error) {
+	var existing []CatalogEntry
+	it := NewCatalogEntriesIterator(catalog, &CatalogEntriesListOptions{
+		CatalogTypeID: catalogTypeID,
+		PageSize:      catalogSyncPageSize,
+	})
+	for it.Next(ctx) {
+		existing = append(existing, it.CatalogEntry())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return planCatalogSync(catalogTypeID, existing, desired, prune)
+}
+
+// planCatalogSync implements PlanCatalogSync for the given existing entries.
+// Archived entries are ignored: they are neither matched nor deleted.
+func planCatalogSync(catalogTypeID string, existing []CatalogEntry, desired []DesiredCatalogEntry, prune bool) (*CatalogSyncPlan, error) {
+	active := make([]CatalogEntry, 0, len(existing))
+	for _, e := range existing {
+		if e.ArchivedAt == nil {
+			active = append(active, e)
+		}
+	}
+	existing = active
+
+	byExternalID := map[string]int{}
+	byAlias := map[string]int{}
+	// ambiguous holds aliases shared by several existing entries.
+	// They can't be used to match an entry.
+	ambiguous := map[string]bool{}
+	for i, e := range existing {
+		if e.ExternalID != "" {
+			byExternalID[e.ExternalID] = i
+		}
+		for _, a := range e.Aliases {
+			if j, ok := byAlias[a]; ok && j != i {
+				ambiguous[a] = true
+			}
+			byAlias[a] = i
+		}
+	}
+
+	plan := &CatalogSyncPlan{CatalogTypeID: catalogTypeID}
+	matched := map[int]string{}
+	seen := map[string]bool{}
+	for _, d := range desired {
+		if d.ExternalID == "" && len(d.Aliases) == 0 {
+			return nil, fmt.Errorf("catalog sync: desired entry %q has neither an external ID nor aliases", d.Name)
+		}
+		if d.ExternalID != "" {
+			if seen[d.ExternalID] {
+				return nil, fmt.Errorf("catalog sync: external ID %q is used by multiple desired entries", d.ExternalID)
+			}
+			seen[d.ExternalID] = true
+		}
+
+		i, ok, err := matchCatalogEntry(d, byExternalID, byAlias, ambiguous)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			plan.Create = append(plan.Create, d)
+			continue
+		}
+		if other, ok := matched[i]; ok {
+			return nil, fmt.Errorf("catalog sync: desired entries %q and %q match the same existing entry %q", other, d.Name, existing[i].ID)
+		}
+		matched[i] = d.Name
+
+		if catalogEntryEqual(existing[i], d) {
+			plan.Unchanged++
+			continue
+		}
+		plan.Update = append(plan.Update, CatalogSyncUpdate{Current: existing[i], Desired: d})
+	}
+
+	if prune {
+		for i, e := range existing {
+			if _, ok := matched[i]; !ok {
+				plan.Delete = append(plan.Delete, e)
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// matchCatalogEntry returns the index of the existing entry matching d.
+// The external ID takes precedence over aliases. An error is returned if d
+// would be matched by an alias that several existing entries share.
+func matchCatalogEntry(d DesiredCatalogEntry, byExternalID, byAlias map[string]int, ambiguous map[string]bool) (int, bool, error) {
+	if d.ExternalID != "" {
+		if i, ok := byExternalID[d.ExternalID]; ok {
+			return i, true, nil
+		}
+	}
+
+	// An existing entry may know the external ID as alias, or the other way around.
+	keys := append([]string{d.ExternalID}, d.Aliases...)
+	for _, k := range keys {
+		if k == "" {
+			continue
+		}
+		if ambiguous[k] {
+			return 0, false, fmt.Errorf("catalog sync: alias %q of desired entry %q is used by multiple existing entries", k, d.Name)
+		}
+		if i, ok := byAlias[k]; ok {
+			return i, true, nil
+		}
+		if i, ok := byExternalID[k]; ok {
+			return i, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// catalogEntryEqual reports whether the existing entry e is in the desired state d.
+func catalogEntryEqual(e CatalogEntry, d DesiredCatalogEntry) bool {
+	if e.Name != d.Name || e.Rank != d.Rank {
+		return false
+	}
+	// Without a desired external ID, the existing one is kept.
+	if d.ExternalID != "" && e.ExternalID != d.ExternalID {
+		return false
+	}
+	if !stringSetEqual(e.Aliases, d.Aliases) {
+		return false
+	}
+
+	for id, want := range d.AttributeValues {
+		got := e.AttributeValues[id]
+
+		var gotValue, wantValue string
+		if got.Value != nil {
+			gotValue = got.Value.Literal
+		}
+		if want.Value != nil {
+			wantValue = want.Value.Literal
+		}
+		if gotValue != wantValue {
+			return false
+		}
+
+		if len(got.ArrayValue) != len(want.ArrayValue) {
+			return false
+		}
+		for i := range got.ArrayValue {
+			if got.ArrayValue[i].Literal != want.ArrayValue[i].Literal {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// stringSetEqual reports whether a and b contain the same strings,
+// ignoring their order.
+func stringSetEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply executes the plan: entries are deleted, updated and created in
+// this order. Deleting first frees the external IDs and aliases of pruned
+// entries, which updated or created entries may take over.
+// Apply stops at the first failing request, changes made until then are
+// not rolled back.
+func (p *CatalogSyncPlan) Apply(ctx context.Context, catalog CatalogAPI) error {
+	for _, e := range p.Delete {
+		if _, err := catalog.DeleteEntry(ctx, e.ID); err != nil {
+			return fmt.Errorf("catalog sync: deleting entry %q (%s): %w", e.Name, e.ID, err)
+		}
+	}
+
+	for _, u := range p.Update {
+		// Updates replace the entry, so keep the external ID unless a new one is desired.
+		externalID := u.Desired.ExternalID
+		if externalID == "" {
+			externalID = u.Current.ExternalID
+		}
+		opts := &UpdateCatalogEntryRequest{
+			Name:             u.Desired.Name,
+			ExternalID:       externalID,
+			Aliases:          u.Desired.Aliases,
+			Rank:             u.Desired.Rank,
+			AttributeValues:  mergeAttributeValues(u.Current.AttributeValues, u.Desired.AttributeValues),
+			UpdateAttributes: attributeIDs(u.Desired.AttributeValues),
+		}
+		if _, _, err := catalog.UpdateEntry(ctx, u.Current.ID, opts); err != nil {
+			return fmt.Errorf("catalog sync: updating entry %q (%s): %w", u.Desired.Name, u.Current.ID, err)
+		}
+	}
+
+	for _, d := range p.Create {
+		opts := &CreateCatalogEntryRequest{
+			CatalogTypeID:   p.CatalogTypeID,
+			Name:            d.Name,
+			ExternalID:      d.ExternalID,
+			Aliases:         d.Aliases,
+			Rank:            d.Rank,
+			AttributeValues: attributeValuesPayload(d.AttributeValues),
+		}
+		if _, _, err := catalog.CreateEntry(ctx, opts); err != nil {
+			return fmt.Errorf("catalog sync: creating entry %q: %w", d.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// attributeValuesPayload returns v, or an empty map if v is nil,
+// as the API requires attribute values to be present.
+func attributeValuesPayload(v map[string]CatalogAttributeBindingPayload) map[string]CatalogAttributeBindingPayload {
+	if v == nil {
+		return map[string]CatalogAttributeBindingPayload{}
+	}
+	return v
+}
+
+// mergeAttributeValues returns the current attribute values of an entry,
+// overwritten by the desired ones. Sending the current values along keeps
+// attributes that are not managed by the sync.
+func mergeAttributeValues(current map[string]CatalogAttributeBinding, desired map[string]CatalogAttributeBindingPayload) map[string]CatalogAttributeBindingPayload {
+	merged := map[string]CatalogAttributeBindingPayload{}
+	for id, b := range current {
+		var p CatalogAttributeBindingPayload
+		if b.Value != nil {
+			p.Value = &CatalogAttributeValuePayload{Literal: b.Value.Literal}
+		}
+		for _, v := range b.ArrayValue {
+			p.ArrayValue = append(p.ArrayValue, CatalogAttributeValuePayload{Literal: v.Literal})
+		}
+		merged[id] = p
+	}
+	for id, p := range desired {
+		merged[id] = p
+	}
+	return merged
+}
+
+// attributeIDs returns the sorted attribute IDs of v, or nil if v is empty.
+func attributeIDs(v map[string]CatalogAttributeBindingPayload) []string {
+	if len(v) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(v))
+	for id := range v {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,464 @@
+package incident
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPlanCatalogSync(t *testing.T) {
+	archived := time.Now()
+
+	tests := []struct {
+		name     string
+		existing []CatalogEntry
+		desired  []DesiredCatalogEntry
+		prune    bool
+
+		wantCreate    []string
+		wantUpdate    []string
+		wantDelete    []string
+		wantUnchanged int
+		wantErr       string
+	}{
+		{
+			name:       "create missing entry",
+			desired:    []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments"}},
+			wantCreate: []string{"Payments"},
+		},
+		{
+			name:          "match by external ID",
+			existing:      []CatalogEntry{{ID: "e1", Name: "Payments", ExternalID: "payments"}},
+			desired:       []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments"}},
+			wantUnchanged: 1,
+		},
+		{
+			name:       "match by external ID with changed name",
+			existing:   []CatalogEntry{{ID: "e1", Name: "Payment", ExternalID: "payments"}},
+			desired:    []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments"}},
+			wantUpdate: []string{"e1"},
+		},
+		{
+			name:          "match by alias",
+			existing:      []CatalogEntry{{ID: "e1", Name: "Payments", Aliases: []string{"pay", "billing"}}},
+			desired:       []DesiredCatalogEntry{{Name: "Payments", Aliases: []string{"billing", "pay"}}},
+			wantUnchanged: 1,
+		},
+		{
+			name:       "external ID stored as alias",
+			existing:   []CatalogEntry{{ID: "e1", Name: "Payments", Aliases: []string{"payments"}}},
+			desired:    []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments"}},
+			wantUpdate: []string{"e1"},
+		},
+		{
+			name:       "alias stored as external ID",
+			existing:   []CatalogEntry{{ID: "e1", Name: "Payments", ExternalID: "pay"}},
+			desired:    []DesiredCatalogEntry{{Name: "Payments", Aliases: []string{"pay"}}},
+			wantUpdate: []string{"e1"},
+		},
+		{
+			name:          "existing external ID without desired one",
+			existing:      []CatalogEntry{{ID: "e1", Name: "Payments", ExternalID: "payments", Aliases: []string{"pay"}}},
+			desired:       []DesiredCatalogEntry{{Name: "Payments", Aliases: []string{"pay"}}},
+			wantUnchanged: 1,
+		},
+		{
+			name:     "two desired entries match one existing entry",
+			existing: []CatalogEntry{{ID: "e1", Name: "Payments", ExternalID: "payments", Aliases: []string{"billing"}}},
+			desired: []DesiredCatalogEntry{
+				{Name: "Payments", ExternalID: "payments"},
+				{Name: "Billing", Aliases: []string{"billing"}},
+			},
+			wantErr: "match the same existing entry",
+		},
+		{
+			name: "alias shared by existing entries",
+			existing: []CatalogEntry{
+				{ID: "e1", Name: "Payments", Aliases: []string{"pay"}},
+				{ID: "e2", Name: "Payroll", Aliases: []string{"pay"}},
+			},
+			desired: []DesiredCatalogEntry{{Name: "Payments", Aliases: []string{"pay"}}},
+			wantErr: "used by multiple existing entries",
+		},
+		{
+			name: "shared alias not used for matching",
+			existing: []CatalogEntry{
+				{ID: "e1", Name: "Payments", ExternalID: "payments", Aliases: []string{"pay"}},
+				{ID: "e2", Name: "Payroll", ExternalID: "payroll", Aliases: []string{"pay"}},
+			},
+			desired:       []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments", Aliases: []string{"pay"}}},
+			wantUnchanged: 1,
+		},
+		{
+			name: "duplicate external ID",
+			desired: []DesiredCatalogEntry{
+				{Name: "Payments", ExternalID: "payments"},
+				{Name: "Payments again", ExternalID: "payments"},
+			},
+			wantErr: "used by multiple desired entries",
+		},
+		{
+			name:    "desired entry without identifier",
+			desired: []DesiredCatalogEntry{{Name: "Payments"}},
+			wantErr: "neither an external ID nor aliases",
+		},
+		{
+			name:       "archived entries are ignored",
+			existing:   []CatalogEntry{{ID: "e1", Name: "Payments", ExternalID: "payments", ArchivedAt: &archived}},
+			desired:    []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments"}},
+			prune:      true,
+			wantCreate: []string{"Payments"},
+		},
+		{
+			name: "prune deletes undesired entries",
+			existing: []CatalogEntry{
+				{ID: "e1", Name: "Payments", ExternalID: "payments"},
+				{ID: "e2", Name: "Legacy", ExternalID: "legacy"},
+			},
+			desired:       []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments"}},
+			prune:         true,
+			wantDelete:    []string{"e2"},
+			wantUnchanged: 1,
+		},
+		{
+			name: "without prune undesired entries are kept",
+			existing: []CatalogEntry{
+				{ID: "e1", Name: "Payments", ExternalID: "payments"},
+				{ID: "e2", Name: "Legacy", ExternalID: "legacy"},
+			},
+			desired:       []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments"}},
+			wantUnchanged: 1,
+		},
+		{
+			name: "changed attribute value",
+			existing: []CatalogEntry{{ID: "e1", Name: "Payments", ExternalID: "payments", AttributeValues: map[string]CatalogAttributeBinding{
+				"owner": {Value: &CatalogAttributeValue{Literal: "team-a"}},
+			}}},
+			desired: []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments", AttributeValues: map[string]CatalogAttributeBindingPayload{
+				"owner": {Value: &CatalogAttributeValuePayload{Literal: "team-b"}},
+			}}},
+			wantUpdate: []string{"e1"},
+		},
+		{
+			name: "unmanaged attributes are not compared",
+			existing: []CatalogEntry{{ID: "e1", Name: "Payments", ExternalID: "payments", AttributeValues: map[string]CatalogAttributeBinding{
+				"owner": {Value: &CatalogAttributeValue{Literal: "team-a"}},
+				"tier":  {Value: &CatalogAttributeValue{Literal: "1"}},
+			}}},
+			desired: []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments", AttributeValues: map[string]CatalogAttributeBindingPayload{
+				"owner": {Value: &CatalogAttributeValuePayload{Literal: "team-a"}},
+			}}},
+			wantUnchanged: 1,
+		},
+		{
+			name: "changed array attribute value",
+			existing: []CatalogEntry{{ID: "e1", Name: "Payments", ExternalID: "payments", AttributeValues: map[string]CatalogAttributeBinding{
+				"services": {ArrayValue: []CatalogAttributeValue{{Literal: "api"}}},
+			}}},
+			desired: []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments", AttributeValues: map[string]CatalogAttributeBindingPayload{
+				"services": {ArrayValue: []CatalogAttributeValuePayload{{Literal: "api"}, {Literal: "worker"}}},
+			}}},
+			wantUpdate: []string{"e1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := planCatalogSync("type-1", tt.existing, tt.desired, tt.prune)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("planCatalogSync returned error %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("planCatalogSync returned error: %v", err)
+			}
+
+			var create, update, del []string
+			for _, d := range plan.Create {
+				create = append(create, d.Name)
+			}
+			for _, u := range plan.Update {
+				update = append(update, u.Current.ID)
+			}
+			for _, e := range plan.Delete {
+				del = append(del, e.ID)
+			}
+
+			if !reflect.DeepEqual(create, tt.wantCreate) {
+				t.Errorf("Create = %v, want %v", create, tt.wantCreate)
+			}
+			if !reflect.DeepEqual(update, tt.wantUpdate) {
+				t.Errorf("Update = %v, want %v", update, tt.wantUpdate)
+			}
+			if !reflect.DeepEqual(del, tt.wantDelete) {
+				t.Errorf("Delete = %v, want %v", del, tt.wantDelete)
+			}
+			if plan.Unchanged != tt.wantUnchanged {
+				t.Errorf("Unchanged = %d, want %d", plan.Unchanged, tt.wantUnchanged)
+			}
+		})
+	}
+}
+
+// recordingCatalog records the write requests of a catalog sync.
+// Calling any other method of CatalogAPI panics.
+type recordingCatalog struct {
+	CatalogAPI
+
+	created []*CreateCatalogEntryRequest
+	updated map[string]*UpdateCatalogEntryRequest
+	deleted []string
+
+	// calls lists the write requests in the order they were made
+	calls []string
+}
+
+func (c *recordingCatalog) CreateEntry(ctx context.Context, opts *CreateCatalogEntryRequest) (*CatalogEntryResponse, *Response, error) {
+	c.created = append(c.created, opts)
+	c.calls = append(c.calls, "create "+opts.ExternalID)
+	return &CatalogEntryResponse{}, nil, nil
+}
+
+func (c *recordingCatalog) UpdateEntry(ctx context.Context, id string, opts *UpdateCatalogEntryRequest) (*CatalogEntryResponse, *Response, error) {
+	if c.updated == nil {
+		c.updated = map[string]*UpdateCatalogEntryRequest{}
+	}
+	c.updated[id] = opts
+	c.calls = append(c.calls, "update "+id)
+	return &CatalogEntryResponse{}, nil, nil
+}
+
+func (c *recordingCatalog) DeleteEntry(ctx context.Context, id string) (*Response, error) {
+	c.deleted = append(c.deleted, id)
+	c.calls = append(c.calls, "delete "+id)
+	return nil, nil
+}
+
+// pagedCatalog serves entries from ListEntries in pages of pageSize.
+// Calling any other method of CatalogAPI panics.
+type pagedCatalog struct {
+	CatalogAPI
+
+	entries  []CatalogEntry
+	pageSize int
+}
+
+func (c *pagedCatalog) ListEntries(ctx context.Context, opts *CatalogEntriesListOptions) (*CatalogEntriesList, *Response, error) {
+	start := 0
+	for i, e := range c.entries {
+		if e.ID == opts.After {
+			start = i + 1
+		}
+	}
+	end := start + c.pageSize
+	if end > len(c.entries) {
+		end = len(c.entries)
+	}
+	return &CatalogEntriesList{
+		CatalogEntries: c.entries[start:end],
+		PaginationMeta: &PaginationMeta{PageSize: int64(c.pageSize)},
+	}, nil, nil
+}
+
+func TestPlanCatalogSync_ListsAllPages(t *testing.T) {
+	catalog := &pagedCatalog{
+		entries: []CatalogEntry{
+			{ID: "e1", Name: "Payments", ExternalID: "payments"},
+			{ID: "e2", Name: "Search", ExternalID: "search"},
+			{ID: "e3", Name: "Legacy", ExternalID: "legacy"},
+		},
+		pageSize: 2,
+	}
+	desired := []DesiredCatalogEntry{
+		{Name: "Payments", ExternalID: "payments"},
+		{Name: "Search", ExternalID: "search"},
+	}
+
+	plan, err := PlanCatalogSync(context.Background(), catalog, "type-1", desired, true)
+	if err != nil {
+		t.Fatalf("PlanCatalogSync returned error: %v", err)
+	}
+	if plan.Unchanged != 2 || len(plan.Create) != 0 || len(plan.Delete) != 1 || plan.Delete[0].ID != "e3" {
+		t.Errorf("plan is %+v, want 2 unchanged entries and e3 deleted", plan)
+	}
+}
+
+func TestCatalogSyncPlan_Apply_KeepsUnmanagedAttributes(t *testing.T) {
+	existing := []CatalogEntry{{
+		ID:         "e1",
+		Name:       "Payments",
+		ExternalID: "payments",
+		AttributeValues: map[string]CatalogAttributeBinding{
+			"owner":    {Value: &CatalogAttributeValue{Literal: "team-a", Label: "Team A"}},
+			"runbooks": {ArrayValue: []CatalogAttributeValue{{Literal: "rb-1"}, {Literal: "rb-2"}}},
+		},
+	}}
+	desired := []DesiredCatalogEntry{{
+		Name:       "Payments",
+		ExternalID: "payments",
+		AttributeValues: map[string]CatalogAttributeBindingPayload{
+			"owner": {Value: &CatalogAttributeValuePayload{Literal: "team-b"}},
+		},
+	}}
+
+	plan, err := planCatalogSync("type-1", existing, desired, false)
+	if err != nil {
+		t.Fatalf("planCatalogSync returned error: %v", err)
+	}
+	catalog := &recordingCatalog{}
+	if err := plan.Apply(context.Background(), catalog); err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+
+	got, ok := catalog.updated["e1"]
+	if !ok {
+		t.Fatalf("Apply did not update e1")
+	}
+	want := map[string]CatalogAttributeBindingPayload{
+		"owner":    {Value: &CatalogAttributeValuePayload{Literal: "team-b"}},
+		"runbooks": {ArrayValue: []CatalogAttributeValuePayload{{Literal: "rb-1"}, {Literal: "rb-2"}}},
+	}
+	if !reflect.DeepEqual(got.AttributeValues, want) {
+		t.Errorf("AttributeValues = %+v, want %+v", got.AttributeValues, want)
+	}
+	if !reflect.DeepEqual(got.UpdateAttributes, []string{"owner"}) {
+		t.Errorf("UpdateAttributes = %v, want [owner]", got.UpdateAttributes)
+	}
+}
+
+func TestCatalogSyncPlan_Apply_WithoutDesiredAttributes(t *testing.T) {
+	existing := []CatalogEntry{{
+		ID:         "e1",
+		Name:       "Payment",
+		ExternalID: "payments",
+		AttributeValues: map[string]CatalogAttributeBinding{
+			"owner": {Value: &CatalogAttributeValue{Literal: "team-a"}},
+		},
+	}}
+	desired := []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments"}}
+
+	plan, err := planCatalogSync("type-1", existing, desired, false)
+	if err != nil {
+		t.Fatalf("planCatalogSync returned error: %v", err)
+	}
+	catalog := &recordingCatalog{}
+	if err := plan.Apply(context.Background(), catalog); err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+
+	// Without desired attributes, all current values are sent, so that
+	// updating all attributes leaves them untouched.
+	got := catalog.updated["e1"]
+	if got == nil || got.UpdateAttributes != nil {
+		t.Fatalf("update request is %+v, want one without UpdateAttributes", got)
+	}
+	if v := got.AttributeValues["owner"].Value; v == nil || v.Literal != "team-a" {
+		t.Errorf("owner attribute is %+v, want team-a kept", v)
+	}
+}
+
+func TestCatalogSyncPlan_Apply_CreateAndDelete(t *testing.T) {
+	existing := []CatalogEntry{{ID: "e2", Name: "Legacy", ExternalID: "legacy"}}
+	desired := []DesiredCatalogEntry{{Name: "Payments", ExternalID: "payments"}}
+
+	plan, err := planCatalogSync("type-1", existing, desired, true)
+	if err != nil {
+		t.Fatalf("planCatalogSync returned error: %v", err)
+	}
+	catalog := &recordingCatalog{}
+	if err := plan.Apply(context.Background(), catalog); err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+
+	if len(catalog.created) != 1 || catalog.created[0].CatalogTypeID != "type-1" || catalog.created[0].AttributeValues == nil {
+		t.Errorf("created %+v, want Payments in type-1 with non-nil attribute values", catalog.created)
+	}
+	if !reflect.DeepEqual(catalog.deleted, []string{"e2"}) {
+		t.Errorf("deleted %v, want [e2]", catalog.deleted)
+	}
+}
+
+func TestCatalogSyncPlan_Apply_DeletesFirst(t *testing.T) {
+	// Payments is matched by its external ID and takes over the alias of
+	// the pruned entry, which the API rejects as long as that entry exists.
+	existing := []CatalogEntry{
+		{ID: "e1", Name: "Payments", ExternalID: "payments"},
+		{ID: "e2", Name: "Legacy", ExternalID: "legacy", Aliases: []string{"pay"}},
+	}
+	desired := []DesiredCatalogEntry{
+		{Name: "Payments", ExternalID: "payments", Aliases: []string{"pay"}},
+		{Name: "Search", ExternalID: "search"},
+	}
+
+	plan, err := planCatalogSync("type-1", existing, desired, true)
+	if err != nil {
+		t.Fatalf("planCatalogSync returned error: %v", err)
+	}
+	catalog := &recordingCatalog{}
+	if err := plan.Apply(context.Background(), catalog); err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+
+	want := []string{"delete e2", "update e1", "create search"}
+	if !reflect.DeepEqual(catalog.calls, want) {
+		t.Errorf("Apply made requests %v, want %v", catalog.calls, want)
+	}
+}
+
+func TestCatalogSyncPlan_Apply_KeepsExternalID(t *testing.T) {
+	tests := []struct {
+		name           string
+		existing       CatalogEntry
+		desired        DesiredCatalogEntry
+		wantExternalID string
+		wantAliases    []string
+	}{
+		{
+			name:           "alias stored as external ID",
+			existing:       CatalogEntry{ID: "e1", Name: "Payments", ExternalID: "pay"},
+			desired:        DesiredCatalogEntry{Name: "Payments", Aliases: []string{"pay"}},
+			wantExternalID: "pay",
+			wantAliases:    []string{"pay"},
+		},
+		{
+			name:           "changed name without desired external ID",
+			existing:       CatalogEntry{ID: "e1", Name: "Payment", ExternalID: "payments", Aliases: []string{"pay"}},
+			desired:        DesiredCatalogEntry{Name: "Payments", Aliases: []string{"pay"}},
+			wantExternalID: "payments",
+			wantAliases:    []string{"pay"},
+		},
+		{
+			name:           "external ID stored as alias",
+			existing:       CatalogEntry{ID: "e1", Name: "Payments", Aliases: []string{"payments"}},
+			desired:        DesiredCatalogEntry{Name: "Payments", ExternalID: "payments"},
+			wantExternalID: "payments",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := planCatalogSync("type-1", []CatalogEntry{tt.existing}, []DesiredCatalogEntry{tt.desired}, false)
+			if err != nil {
+				t.Fatalf("planCatalogSync returned error: %v", err)
+			}
+			catalog := &recordingCatalog{}
+			if err := plan.Apply(context.Background(), catalog); err != nil {
+				t.Fatalf("Apply returned error: %v", err)
+			}
+
+			got := catalog.updated["e1"]
+			if got == nil {
+				t.Fatal("Apply did not update e1")
+			}
+			if got.ExternalID != tt.wantExternalID {
+				t.Errorf("ExternalID = %q, want %q", got.ExternalID, tt.wantExternalID)
+			}
+			if !reflect.DeepEqual(got.Aliases, tt.wantAliases) {
+				t.Errorf("Aliases = %v, want %v", got.Aliases, tt.wantAliases)
+			}
+		})
+	}
+}
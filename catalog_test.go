@@ -0,0 +1,187 @@
+package incident
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestCatalogService_Requests(t *testing.T) {
+	var method, path string
+	var query url.Values
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, query, body = r.Method, r.URL.Path, r.URL.Query(), nil
+		if r.Method != http.MethodGet && r.Method != http.MethodDelete {
+			json.NewDecoder(r.Body).Decode(&body)
+		}
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		call      func() error
+		wantPath  string
+		wantQuery url.Values
+		wantBody  map[string]interface{}
+	}{
+		{
+			name:     "ListTypes",
+			call:     func() error { _, _, err := c.Catalog.ListTypes(ctx); return err },
+			wantPath: "GET /v2/catalog_types",
+		},
+		{
+			name:     "GetType",
+			call:     func() error { _, _, err := c.Catalog.GetType(ctx, "type_1"); return err },
+			wantPath: "GET /v2/catalog_types/type_1",
+		},
+		{
+			name: "CreateType",
+			call: func() error {
+				_, _, err := c.Catalog.CreateType(ctx, &CreateCatalogTypeRequest{Name: "Service", Description: "Services", TypeName: "Custom[\"Service\"]"})
+				return err
+			},
+			wantPath: "POST /v2/catalog_types",
+			wantBody: map[string]interface{}{"name": "Service", "description": "Services", "type_name": "Custom[\"Service\"]"},
+		},
+		{
+			name: "UpdateType",
+			call: func() error {
+				_, _, err := c.Catalog.UpdateType(ctx, "type_1", &UpdateCatalogTypeRequest{Name: "Service", Description: "Services", Ranked: true})
+				return err
+			},
+			wantPath: "PUT /v2/catalog_types/type_1",
+			wantBody: map[string]interface{}{"name": "Service", "description": "Services", "ranked": true},
+		},
+		{
+			name: "UpdateTypeSchema",
+			call: func() error {
+				_, _, err := c.Catalog.UpdateTypeSchema(ctx, "type_1", &UpdateCatalogTypeSchemaRequest{
+					Version:    2,
+					Attributes: []CatalogTypeAttribute{{Name: "Owner", Type: "String"}},
+				})
+				return err
+			},
+			wantPath: "POST /v2/catalog_types/type_1/actions/update_schema",
+			wantBody: map[string]interface{}{
+				"version":    float64(2),
+				"attributes": []interface{}{map[string]interface{}{"name": "Owner", "type": "String", "array": false}},
+			},
+		},
+		{
+			name:     "DeleteType",
+			call:     func() error { _, err := c.Catalog.DeleteType(ctx, "type_1"); return err },
+			wantPath: "DELETE /v2/catalog_types/type_1",
+		},
+		{
+			name: "ListEntries",
+			call: func() error {
+				_, _, err := c.Catalog.ListEntries(ctx, &CatalogEntriesListOptions{CatalogTypeID: "type_1", PageSize: 25, After: "e1"})
+				return err
+			},
+			wantPath:  "GET /v2/catalog_entries",
+			wantQuery: url.Values{"catalog_type_id": {"type_1"}, "page_size": {"25"}, "after": {"e1"}},
+		},
+		{
+			name:     "GetEntry",
+			call:     func() error { _, _, err := c.Catalog.GetEntry(ctx, "e1"); return err },
+			wantPath: "GET /v2/catalog_entries/e1",
+		},
+		{
+			name: "CreateEntry",
+			call: func() error {
+				_, _, err := c.Catalog.CreateEntry(ctx, &CreateCatalogEntryRequest{
+					CatalogTypeID: "type_1",
+					Name:          "Payments",
+					ExternalID:    "payments",
+					Aliases:       []string{"pay"},
+					AttributeValues: map[string]CatalogAttributeBindingPayload{
+						"owner":    {Value: &CatalogAttributeValuePayload{Literal: "team-a"}},
+						"runbooks": {ArrayValue: []CatalogAttributeValuePayload{{Literal: "rb-1"}}},
+					},
+				})
+				return err
+			},
+			wantPath: "POST /v2/catalog_entries",
+			wantBody: map[string]interface{}{
+				"catalog_type_id": "type_1",
+				"name":            "Payments",
+				"external_id":     "payments",
+				"aliases":         []interface{}{"pay"},
+				"attribute_values": map[string]interface{}{
+					"owner":    map[string]interface{}{"value": map[string]interface{}{"literal": "team-a"}},
+					"runbooks": map[string]interface{}{"array_value": []interface{}{map[string]interface{}{"literal": "rb-1"}}},
+				},
+			},
+		},
+		{
+			name: "UpdateEntry",
+			call: func() error {
+				_, _, err := c.Catalog.UpdateEntry(ctx, "e1", &UpdateCatalogEntryRequest{
+					Name: "Payments",
+					AttributeValues: map[string]CatalogAttributeBindingPayload{
+						"owner": {Value: &CatalogAttributeValuePayload{Literal: "team-b"}},
+					},
+					UpdateAttributes: []string{"owner"},
+				})
+				return err
+			},
+			wantPath: "PUT /v2/catalog_entries/e1",
+			wantBody: map[string]interface{}{
+				"name": "Payments",
+				"attribute_values": map[string]interface{}{
+					"owner": map[string]interface{}{"value": map[string]interface{}{"literal": "team-b"}},
+				},
+				"update_attributes": []interface{}{"owner"},
+			},
+		},
+		{
+			name: "UpdateEntry without attribute values",
+			call: func() error {
+				_, _, err := c.Catalog.UpdateEntry(ctx, "e1", &UpdateCatalogEntryRequest{Name: "Payments"})
+				return err
+			},
+			wantPath: "PUT /v2/catalog_entries/e1",
+			wantBody: map[string]interface{}{"name": "Payments", "attribute_values": nil},
+		},
+		{
+			name:     "DeleteEntry",
+			call:     func() error { _, err := c.Catalog.DeleteEntry(ctx, "e1"); return err },
+			wantPath: "DELETE /v2/catalog_entries/e1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Fatalf("returned error: %v", err)
+			}
+			if got := method + " " + path; got != tt.wantPath {
+				t.Errorf("sent %s, want %s", got, tt.wantPath)
+			}
+			if tt.wantQuery == nil {
+				tt.wantQuery = url.Values{}
+			}
+			if !reflect.DeepEqual(query, tt.wantQuery) {
+				t.Errorf("sent query %v, want %v", query, tt.wantQuery)
+			}
+			if !reflect.DeepEqual(body, tt.wantBody) {
+				t.Errorf("sent body %v, want %v", body, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package incident
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrCustomFieldNotFound is returned by CustomFieldEntriesBuilder if
+// no custom field matches the given name or ID.
+var ErrCustomFieldNotFound = errors.New("custom field not found")
+
+// decimalPattern matches plain decimal numbers like "42", "-1.5" or ".5".
+// Exponents, hex floats, NaN and Inf are not accepted.
+var decimalPattern = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+
+// CustomFieldOptionError is returned by CustomFieldEntriesBuilder if a value
+// doesn't match any option of a select custom field.
+type CustomFieldOptionError struct {
+	// Name of the custom field
+	Field string
+
+	// Value that didn't match any option
+	Value string
+
+	// Closest option value, if any is close enough to be a likely typo
+	Suggestion string
+}
+
+func (e *CustomFieldOptionError) Error() string {
+	msg := fmt.Sprintf("custom field %q has no option %q", e.Field, e.Value)
+	if e.Suggestion != "" {
+		msg += fmt.Sprintf(", did you mean %q?", e.Suggestion)
+	}
+	return msg
+}
+
+// CustomFieldEntriesBuilder builds the custom field entries of
+// CreateIncidentRequest and EditIncidentFields.
+// Custom fields are referenced by name or ID, and every value is validated
+// against the type of its custom field before it is added.
+//
+//	b := incident.NewCustomFieldEntriesBuilder(fields.CustomFields)
+//	if err := b.Set("Affected team", "Platform"); err != nil {
+//		// Handle error
+//	}
+//	req.CustomFieldEntries = b.Entries()
+type CustomFieldEntriesBuilder struct {
+	fields  []CustomField
+	entries []CustomFieldEntryPayload
+
+	// index maps custom field IDs to their position in entries
+	index map[string]int
+}
+
+// NewCustomFieldEntriesBuilder returns a builder for the given custom fields,
+// usually the result of CustomFieldsService.List.
+func NewCustomFieldEntriesBuilder(fields []CustomField) *CustomFieldEntriesBuilder {
+	return &CustomFieldEntriesBuilder{
+		fields: fields,
+		index:  map[string]int{},
+	}
+}
+
+// Set sets the values of the custom field named or identified by field.
+// How values are interpreted depends on the type of the custom field:
+//
+//   - text and link fields accept a single value, links must be absolute URLs
+//   - numeric fields accept a single plain decimal number, like "42" or "-1.5"
+//   - single select fields accept a single option, multi select fields
+//     accept several options, referenced by value (case-insensitive) or ID
+//   - catalog-backed select fields accept catalog entry IDs
+//
+// A multi select field can't be set to the same option or catalog entry twice.
+// Setting a field without values, or with a single empty value, clears it.
+// Setting a field twice replaces the values set before.
+func (b *CustomFieldEntriesBuilder) Set(field string, values ...string) error {
+	f, err := b.field(field)
+	if err != nil {
+		return err
+	}
+
+	// An empty value would be sent as a value without any member set,
+	// which the API rejects.
+	if len(values) == 1 && values[0] == "" {
+		values = nil
+	}
+	if len(values) > 1 && f.FieldType != CustomFieldTypeMultiSelect {
+		return fmt.Errorf("custom field %q of type %s accepts a single value, got %d", f.Name, f.FieldType, len(values))
+	}
+
+	payloads := make([]CustomFieldValuePayload, 0, len(values))
+	seen := make(map[CustomFieldValuePayload]string, len(values))
+	for _, value := range values {
+		p, err := b.value(f, value)
+		if err != nil {
+			return err
+		}
+		// An option can be referenced by value and ID, so compare resolved payloads.
+		if prev, ok := seen[p]; ok {
+			return fmt.Errorf("custom field %q got the same value twice: %q and %q", f.Name, prev, value)
+		}
+		seen[p] = value
+		payloads = append(payloads, p)
+	}
+
+	b.set(f.Id, payloads)
+	return nil
+}
+
+// SetNumeric sets the value of the numeric custom field named or identified by field.
+// value must be finite.
+func (b *CustomFieldEntriesBuilder) SetNumeric(field string, value float64) error {
+	f, err := b.field(field)
+	if err != nil {
+		return err
+	}
+	if f.FieldType != CustomFieldTypeNumeric {
+		return fmt.Errorf("custom field %q is of type %s, not %s", f.Name, f.FieldType, CustomFieldTypeNumeric)
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("custom field %q expects a finite number, got %v", f.Name, value)
+	}
+
+	b.set(f.Id, []CustomFieldValuePayload{
+		{ValueNumeric: strconv.FormatFloat(value, 'f', -1, 64)},
+	})
+	return nil
+}
+
+// Clear clears the custom field named or identified by field.
+func (b *CustomFieldEntriesBuilder) Clear(field string) error {
+	return b.Set(field)
+}
+
+// Entries returns the custom field entries in the order they were first set.
+func (b *CustomFieldEntriesBuilder) Entries() []CustomFieldEntryPayload {
+	entries := make([]CustomFieldEntryPayload, len(b.entries))
+	copy(entries, b.entries)
+	return entries
+}
+
+func (b *CustomFieldEntriesBuilder) set(id string, values []CustomFieldValuePayload) {
+	entry := CustomFieldEntryPayload{
+		CustomFieldID: id,
+		Values:        values,
+	}
+
+	if i, ok := b.index[id]; ok {
+		b.entries[i] = entry
+		return
+	}
+	b.index[id] = len(b.entries)
+	b.entries = append(b.entries, entry)
+}
+
+// field looks up a custom field by ID first and by name second.
+func (b *CustomFieldEntriesBuilder) field(field string) (*CustomField, error) {
+	for i := range b.fields {
+		if b.fields[i].Id == field {
+			return &b.fields[i], nil
+		}
+	}
+
+	names := make([]string, 0, len(b.fields))
+	for i := range b.fields {
+		if strings.EqualFold(b.fields[i].Name, field) {
+			return &b.fields[i], nil
+		}
+		names = append(names, b.fields[i].Name)
+	}
+
+	if s := suggest(field, names); s != "" {
+		return nil, fmt.Errorf("%w: %q, did you mean %q?", ErrCustomFieldNotFound, field, s)
+	}
+	return nil, fmt.Errorf("%w: %q", ErrCustomFieldNotFound, field)
+}
+
+func (b *CustomFieldEntriesBuilder) value(f *CustomField, value string) (CustomFieldValuePayload, error) {
+	switch f.FieldType {
+	case CustomFieldTypeText:
+		return CustomFieldValuePayload{ValueText: value}, nil
+
+	case CustomFieldTypeLink:
+		u, err := url.Parse(value)
+		if err != nil || !u.IsAbs() || u.Host == "" {
+			return CustomFieldValuePayload{}, fmt.Errorf("custom field %q expects an absolute URL, got %q", f.Name, value)
+		}
+		return CustomFieldValuePayload{ValueLink: value}, nil
+
+	case CustomFieldTypeNumeric:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(n) || math.IsInf(n, 0) || !decimalPattern.MatchString(value) {
+			return CustomFieldValuePayload{}, fmt.Errorf("custom field %q expects a number, got %q", f.Name, value)
+		}
+		return CustomFieldValuePayload{ValueNumeric: value}, nil
+
+	case CustomFieldTypeSingleSelect, CustomFieldTypeMultiSelect:
+		if f.CatalogTypeID != "" {
+			if value == "" {
+				return CustomFieldValuePayload{}, fmt.Errorf("custom field %q expects a catalog entry ID", f.Name)
+			}
+			return CustomFieldValuePayload{ValueCatalogEntryID: value}, nil
+		}
+
+		values := make([]string, 0, len(f.Options))
+		for _, o := range f.Options {
+			if o.Id == value || strings.EqualFold(o.Value, value) {
+				return CustomFieldValuePayload{ValueOptionID: o.Id}, nil
+			}
+			values = append(values, o.Value)
+		}
+		return CustomFieldValuePayload{}, &CustomFieldOptionError{
+			Field:      f.Name,
+			Value:      value,
+			Suggestion: suggest(value, values),
+		}
+	}
+
+	return CustomFieldValuePayload{}, fmt.Errorf("custom field %q has unsupported type %s", f.Name, f.FieldType)
+}
+
+// suggest returns the candidate closest to s, if it is close enough
+// to assume a typo. Otherwise it returns an empty string.
+func suggest(s string, candidates []string) string {
+	s = strings.ToLower(s)
+
+	best, bestDistance := "", -1
+	for _, c := range candidates {
+		d := levenshtein(s, strings.ToLower(c))
+		if bestDistance == -1 || d < bestDistance {
+			best, bestDistance = c, d
+		}
+	}
+
+	// Allow one edit per three characters, but at least two.
+	limit := len([]rune(s)) / 3
+	if limit < 2 {
+		limit = 2
+	}
+	if bestDistance == -1 || bestDistance > limit {
+		return ""
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			cur[j] = prev[j] + 1
+			if v := cur[j-1] + 1; v < cur[j] {
+				cur[j] = v
+			}
+			if v := prev[j-1] + cost; v < cur[j] {
+				cur[j] = v
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
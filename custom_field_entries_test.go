@@ -0,0 +1,203 @@
+package incident
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testCustomFields = []CustomField{
+	{Id: "cf_text", Name: "Summary notes", FieldType: CustomFieldTypeText},
+	{Id: "cf_link", Name: "Runbook", FieldType: CustomFieldTypeLink},
+	{Id: "cf_num", Name: "Affected customers", FieldType: CustomFieldTypeNumeric},
+	{Id: "cf_single", Name: "Affected team", FieldType: CustomFieldTypeSingleSelect, Options: []CustomFieldOption{
+		{Id: "opt_platform", Value: "Platform"},
+		{Id: "opt_payments", Value: "Payments"},
+	}},
+	{Id: "cf_multi", Name: "Products", FieldType: CustomFieldTypeMultiSelect, Options: []CustomFieldOption{
+		{Id: "opt_api", Value: "API"},
+		{Id: "opt_dashboard", Value: "Dashboard"},
+	}},
+	{Id: "cf_catalog", Name: "Services", FieldType: CustomFieldTypeMultiSelect, CatalogTypeID: "type_1"},
+}
+
+func TestCustomFieldEntriesBuilder_Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   string
+		values  []string
+		want    []CustomFieldValuePayload
+		wantErr bool
+	}{
+		{name: "field by ID", field: "cf_text", values: []string{"notes"}, want: []CustomFieldValuePayload{{ValueText: "notes"}}},
+		{name: "field by name", field: "summary NOTES", values: []string{"notes"}, want: []CustomFieldValuePayload{{ValueText: "notes"}}},
+		{name: "unknown field", field: "Unknown", values: []string{"notes"}, wantErr: true},
+
+		{name: "absolute link", field: "Runbook", values: []string{"https://example.com/runbook"}, want: []CustomFieldValuePayload{{ValueLink: "https://example.com/runbook"}}},
+		{name: "relative link", field: "Runbook", values: []string{"/runbook"}, wantErr: true},
+		{name: "link without host", field: "Runbook", values: []string{"mailto:jane@example.com"}, wantErr: true},
+		{name: "malformed link", field: "Runbook", values: []string{"http://[::1"}, wantErr: true},
+
+		{name: "integer", field: "Affected customers", values: []string{"42"}, want: []CustomFieldValuePayload{{ValueNumeric: "42"}}},
+		{name: "decimal", field: "Affected customers", values: []string{"-1.5"}, want: []CustomFieldValuePayload{{ValueNumeric: "-1.5"}}},
+		{name: "leading dot", field: "Affected customers", values: []string{".5"}, want: []CustomFieldValuePayload{{ValueNumeric: ".5"}}},
+		{name: "not a number", field: "Affected customers", values: []string{"many"}, wantErr: true},
+		{name: "NaN", field: "Affected customers", values: []string{"NaN"}, wantErr: true},
+		{name: "Inf", field: "Affected customers", values: []string{"Inf"}, wantErr: true},
+		{name: "negative Inf", field: "Affected customers", values: []string{"-Inf"}, wantErr: true},
+		{name: "hex float", field: "Affected customers", values: []string{"0x1p-2"}, wantErr: true},
+		{name: "exponent", field: "Affected customers", values: []string{"1e3"}, wantErr: true},
+		{name: "out of range", field: "Affected customers", values: []string{"1" + strings.Repeat("0", 400)}, wantErr: true},
+
+		{name: "option by value", field: "Affected team", values: []string{"platform"}, want: []CustomFieldValuePayload{{ValueOptionID: "opt_platform"}}},
+		{name: "option by ID", field: "Affected team", values: []string{"opt_payments"}, want: []CustomFieldValuePayload{{ValueOptionID: "opt_payments"}}},
+		{name: "unknown option", field: "Affected team", values: []string{"Sales"}, wantErr: true},
+
+		{name: "single value for text", field: "Summary notes", values: []string{"a", "b"}, wantErr: true},
+		{name: "single value for single select", field: "Affected team", values: []string{"Platform", "Payments"}, wantErr: true},
+		{name: "multiple values for multi select", field: "Products", values: []string{"API", "opt_dashboard"}, want: []CustomFieldValuePayload{{ValueOptionID: "opt_api"}, {ValueOptionID: "opt_dashboard"}}},
+		{name: "duplicate option", field: "Products", values: []string{"API", "api"}, wantErr: true},
+		{name: "duplicate option by value and ID", field: "Products", values: []string{"API", "opt_api"}, wantErr: true},
+
+		{name: "catalog entries", field: "Services", values: []string{"entry_1", "entry_2"}, want: []CustomFieldValuePayload{{ValueCatalogEntryID: "entry_1"}, {ValueCatalogEntryID: "entry_2"}}},
+		{name: "duplicate catalog entry", field: "Services", values: []string{"entry_1", "entry_1"}, wantErr: true},
+		{name: "empty catalog entry among others", field: "Services", values: []string{"entry_1", ""}, wantErr: true},
+
+		{name: "clear", field: "Products", values: nil, want: []CustomFieldValuePayload{}},
+		{name: "empty text clears", field: "Summary notes", values: []string{""}, want: []CustomFieldValuePayload{}},
+		{name: "empty link clears", field: "Runbook", values: []string{""}, want: []CustomFieldValuePayload{}},
+		{name: "empty number clears", field: "Affected customers", values: []string{""}, want: []CustomFieldValuePayload{}},
+		{name: "empty option clears", field: "Affected team", values: []string{""}, want: []CustomFieldValuePayload{}},
+		{name: "empty catalog entry clears", field: "Services", values: []string{""}, want: []CustomFieldValuePayload{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewCustomFieldEntriesBuilder(testCustomFields)
+			err := b.Set(tt.field, tt.values...)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Set returned no error, want error")
+				}
+				if got := b.Entries(); len(got) != 0 {
+					t.Errorf("Set added entries %+v despite error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Set returned error: %v", err)
+			}
+
+			got := b.Entries()
+			if len(got) != 1 {
+				t.Fatalf("Entries returned %d entries, want 1", len(got))
+			}
+			if !reflect.DeepEqual(got[0].Values, tt.want) {
+				t.Errorf("values are %+v, want %+v", got[0].Values, tt.want)
+			}
+		})
+	}
+}
+
+func TestCustomFieldEntriesBuilder_Suggestions(t *testing.T) {
+	b := NewCustomFieldEntriesBuilder(testCustomFields)
+
+	err := b.Set("Affected team", "Platfrom")
+	var optErr *CustomFieldOptionError
+	if !errors.As(err, &optErr) {
+		t.Fatalf("Set returned error %v, want *CustomFieldOptionError", err)
+	}
+	if optErr.Field != "Affected team" || optErr.Value != "Platfrom" || optErr.Suggestion != "Platform" {
+		t.Errorf("error is %+v, want suggestion Platform", optErr)
+	}
+
+	err = b.Set("Afected team", "Platform")
+	if !errors.Is(err, ErrCustomFieldNotFound) {
+		t.Fatalf("Set returned error %v, want %v", err, ErrCustomFieldNotFound)
+	}
+	if want := `custom field not found: "Afected team", did you mean "Affected team"?`; err.Error() != want {
+		t.Errorf("error is %q, want %q", err, want)
+	}
+}
+
+func TestCustomFieldEntriesBuilder_SetTwice(t *testing.T) {
+	b := NewCustomFieldEntriesBuilder(testCustomFields)
+	for _, step := range []struct {
+		field  string
+		values []string
+	}{
+		{"Products", []string{"API"}},
+		{"Summary notes", []string{"notes"}},
+		{"cf_multi", []string{"Dashboard"}},
+	} {
+		if err := b.Set(step.field, step.values...); err != nil {
+			t.Fatalf("Set(%q) returned error: %v", step.field, err)
+		}
+	}
+	if err := b.SetNumeric("Affected customers", 1.5); err != nil {
+		t.Fatalf("SetNumeric returned error: %v", err)
+	}
+	if err := b.SetNumeric("Summary notes", 1); err == nil {
+		t.Errorf("SetNumeric on text field returned no error")
+	}
+	for _, v := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if err := b.SetNumeric("Affected customers", v); err == nil {
+			t.Errorf("SetNumeric(%v) returned no error", v)
+		}
+	}
+
+	want := []CustomFieldEntryPayload{
+		{CustomFieldID: "cf_multi", Values: []CustomFieldValuePayload{{ValueOptionID: "opt_dashboard"}}},
+		{CustomFieldID: "cf_text", Values: []CustomFieldValuePayload{{ValueText: "notes"}}},
+		{CustomFieldID: "cf_num", Values: []CustomFieldValuePayload{{ValueNumeric: "1.5"}}},
+	}
+	if got := b.Entries(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Entries = %+v, want %+v", got, want)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	candidates := []string{"Platform", "Payments", "Customer success"}
+
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "platform", want: "Platform"},
+		{s: "Platfrom", want: "Platform"},
+		{s: "Paymnts", want: "Payments"},
+		{s: "Customer sucess", want: "Customer success"},
+		{s: "Sales", want: ""},
+		{s: "", want: ""},
+	}
+	for _, tt := range tests {
+		if got := suggest(tt.s, candidates); got != tt.want {
+			t.Errorf("suggest(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+
+	if got := suggest("Platform", nil); got != "" {
+		t.Errorf("suggest without candidates = %q, want none", got)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"platform", "platfrom", 2},
+		{"café", "cafe", 1},
+	}
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
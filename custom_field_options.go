@@ -0,0 +1,276 @@
+package incident
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// customFieldOptionsSortKeyStep is the gap between the sort keys assigned
+// by CustomFieldOptionsService.Reorder. The gap allows inserting options
+// later on without reordering all of them.
+const customFieldOptionsSortKeyStep = 10
+
+// CustomFieldOptionsService handles communication with the custom field option related
+// methods of the Incident.io API.
+//
+// API docs: https://api-docs.incident.io/#tag/Custom-Field-Options
+type CustomFieldOptionsService service
+
+// List list the options of a custom field.
+// The custom field ID of opts is required.
+//
+// API docs: https://api-docs.incident.io/#operation/Custom%20Field%20Options_List
+func (s *CustomFieldOptionsService) List(ctx context.Context, opts *CustomFieldOptionsListOptions) (*CustomFieldOptionsList, *Response, error) {
+	u := "custom_field_options"
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CustomFieldOptionsList{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Iter returns an iterator over all custom field options matching opts.
+// The iterator follows the After cursor of the API and fetches one page
+// at a time. opts is copied and not modified by the iterator.
+func (s *CustomFieldOptionsService) Iter(opts *CustomFieldOptionsListOptions) *CustomFieldOptionsIterator {
+	return NewCustomFieldOptionsIterator(s, opts)
+}
+
+// ListAll calls fn for every custom field option matching opts, following all pages.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func (s *CustomFieldOptionsService) ListAll(ctx context.Context, opts *CustomFieldOptionsListOptions, fn func(CustomFieldOption) error) error {
+	return ListAllCustomFieldOptions(ctx, s, opts, fn)
+}
+
+// CustomFieldOptionsIterator iterates over the pages of CustomFieldOptionsService.List.
+// Create one with CustomFieldOptionsService.Iter.
+type CustomFieldOptionsIterator struct {
+	pager *cursorPager[CustomFieldOption]
+}
+
+// NewCustomFieldOptionsIterator returns an iterator over all custom field options matching opts,
+// that fetches the pages with api.List.
+// This is useful to iterate over custom field options of a mock CustomFieldOptionsAPI.
+// opts is copied and not modified by the iterator.
+func NewCustomFieldOptionsIterator(api CustomFieldOptionsAPI, opts *CustomFieldOptionsListOptions) *CustomFieldOptionsIterator {
+	o := CustomFieldOptionsListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	fetch := func(ctx context.Context, after string) ([]CustomFieldOption, *PaginationMeta, error) {
+		o.After = after
+		list, _, err := api.List(ctx, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+		return list.CustomFieldOptions, list.PaginationMeta, nil
+	}
+	id := func(v CustomFieldOption) string { return v.Id }
+
+	return &CustomFieldOptionsIterator{pager: newCursorPager(o.After, fetch, id)}
+}
+
+// ListAllCustomFieldOptions calls fn for every custom field option matching opts, following all pages
+// fetched with api.List.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func ListAllCustomFieldOptions(ctx context.Context, api CustomFieldOptionsAPI, opts *CustomFieldOptionsListOptions, fn func(CustomFieldOption) error) error {
+	return forEach(ctx, NewCustomFieldOptionsIterator(api, opts).pager, fn)
+}
+
+// Next advances the iterator to the next custom field option, fetching the next page
+// if required. It returns false when there are no more custom field options or an error
+// occurred. Check Err after Next returned false.
+func (it *CustomFieldOptionsIterator) Next(ctx context.Context) bool {
+	return it.pager.next(ctx)
+}
+
+// CustomFieldOption returns the current custom field option.
+// It is only valid after a call to Next returned true.
+func (it *CustomFieldOptionsIterator) CustomFieldOption() CustomFieldOption {
+	return it.pager.current
+}
+
+// Err returns the first error that occurred during iteration, if any.
+func (it *CustomFieldOptionsIterator) Err() error {
+	return it.pager.err
+}
+
+// Get returns a single custom field option.
+//
+// id represents the unique identifier for the custom field option
+//
+// API docs: https://api-docs.incident.io/#operation/Custom%20Field%20Options_Show
+func (s *CustomFieldOptionsService) Get(ctx context.Context, id string) (*CustomFieldOptionResponse, *Response, error) {
+	u := fmt.Sprintf("custom_field_options/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CustomFieldOptionResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Create creates a new option of a custom field.
+//
+// API docs: https://api-docs.incident.io/#operation/Custom%20Field%20Options_Create
+func (s *CustomFieldOptionsService) Create(ctx context.Context, opts *CreateCustomFieldOptionRequest) (*CustomFieldOptionResponse, *Response, error) {
+	u := "custom_field_options"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CustomFieldOptionResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Update updates an existing custom field option.
+//
+// id represents the unique identifier for the custom field option
+//
+// API docs: https://api-docs.incident.io/#operation/Custom%20Field%20Options_Update
+func (s *CustomFieldOptionsService) Update(ctx context.Context, id string, opts *UpdateCustomFieldOptionRequest) (*CustomFieldOptionResponse, *Response, error) {
+	u := fmt.Sprintf("custom_field_options/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CustomFieldOptionResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Delete deletes a custom field option.
+//
+// id represents the unique identifier for the custom field option
+//
+// API docs: https://api-docs.incident.io/#operation/Custom%20Field%20Options_Delete
+func (s *CustomFieldOptionsService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("custom_field_options/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Reorder orders the options of a custom field as given by optionIDs.
+// See ReorderCustomFieldOptions.
+func (s *CustomFieldOptionsService) Reorder(ctx context.Context, customFieldID string, optionIDs []string) (*Response, error) {
+	return ReorderCustomFieldOptions(ctx, s, customFieldID, optionIDs)
+}
+
+// ReorderCustomFieldOptions orders the options of a custom field as given
+// by optionIDs, by assigning ascending sort keys with api.Update.
+// Options of the custom field not listed in optionIDs are placed after the
+// listed ones, in their previous order.
+// Only options whose sort key changes are updated.
+//
+// The returned Response is the one of the last API call,
+// e.g. of the update that failed.
+func ReorderCustomFieldOptions(ctx context.Context, api CustomFieldOptionsAPI, customFieldID string, optionIDs []string) (*Response, error) {
+	var current []CustomFieldOption
+	lister := &lastResponseOptionsAPI{CustomFieldOptionsAPI: api}
+	opts := &CustomFieldOptionsListOptions{CustomFieldID: customFieldID}
+	err := ListAllCustomFieldOptions(ctx, lister, opts, func(o CustomFieldOption) error {
+		current = append(current, o)
+		return nil
+	})
+	resp := lister.resp
+	if err != nil {
+		return resp, err
+	}
+	sort.SliceStable(current, func(i, j int) bool {
+		return current[i].SortKey < current[j].SortKey
+	})
+
+	byID := make(map[string]CustomFieldOption, len(current))
+	for _, o := range current {
+		byID[o.Id] = o
+	}
+
+	ordered := make([]CustomFieldOption, 0, len(current))
+	listed := make(map[string]bool, len(optionIDs))
+	for _, id := range optionIDs {
+		o, ok := byID[id]
+		if !ok {
+			return resp, fmt.Errorf("custom field option %q does not belong to custom field %q", id, customFieldID)
+		}
+		if listed[id] {
+			return resp, fmt.Errorf("custom field option %q is listed more than once", id)
+		}
+		listed[id] = true
+		ordered = append(ordered, o)
+	}
+	for _, o := range current {
+		if !listed[o.Id] {
+			ordered = append(ordered, o)
+		}
+	}
+
+	for i, o := range ordered {
+		sortKey := int64(i+1) * customFieldOptionsSortKeyStep
+		if o.SortKey == sortKey {
+			continue
+		}
+
+		update := &UpdateCustomFieldOptionRequest{
+			Value:   o.Value,
+			SortKey: Int64(sortKey),
+		}
+		var err error
+		if _, resp, err = api.Update(ctx, o.Id, update); err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+// lastResponseOptionsAPI records the Response of the last call to List.
+type lastResponseOptionsAPI struct {
+	CustomFieldOptionsAPI
+	resp *Response
+}
+
+func (a *lastResponseOptionsAPI) List(ctx context.Context, opts *CustomFieldOptionsListOptions) (*CustomFieldOptionsList, *Response, error) {
+	v, resp, err := a.CustomFieldOptionsAPI.List(ctx, opts)
+	a.resp = resp
+	return v, resp, err
+}
@@ -0,0 +1,203 @@
+package incident
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newReorderTestClient returns a client for a server that lists options
+// and records the sort keys of updates by option ID.
+func newReorderTestClient(t *testing.T, options []CustomFieldOption, updates map[string]int64) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(CustomFieldOptionsList{CustomFieldOptions: options})
+		case r.Method == http.MethodPut:
+			var req UpdateCustomFieldOptionRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			updates[strings.TrimPrefix(r.URL.Path, "/v1/custom_field_options/")] = *req.SortKey
+			io.WriteString(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+	return c
+}
+
+func TestCustomFieldOptionsService_Reorder(t *testing.T) {
+	options := []CustomFieldOption{
+		{Id: "a", Value: "A", SortKey: 10},
+		{Id: "b", Value: "B", SortKey: 20},
+		{Id: "c", Value: "C", SortKey: 30},
+		{Id: "d", Value: "D", SortKey: 40},
+	}
+
+	tests := []struct {
+		name      string
+		optionIDs []string
+		want      map[string]int64
+		wantErr   bool
+	}{
+		{name: "unchanged order", optionIDs: []string{"a", "b", "c", "d"}, want: map[string]int64{}},
+		{name: "swap", optionIDs: []string{"b", "a", "c", "d"}, want: map[string]int64{"a": 20, "b": 10}},
+		{name: "unlisted options follow in previous order", optionIDs: []string{"d"}, want: map[string]int64{"d": 10, "a": 20, "b": 30, "c": 40}},
+		{name: "no options listed", optionIDs: nil, want: map[string]int64{}},
+		{name: "unknown option", optionIDs: []string{"x"}, wantErr: true},
+		{name: "duplicate option", optionIDs: []string{"a", "a"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates := map[string]int64{}
+			c := newReorderTestClient(t, options, updates)
+
+			resp, err := c.CustomFieldOptions.Reorder(context.Background(), "cf_1", tt.optionIDs)
+			if resp == nil {
+				t.Error("Reorder returned no response")
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Reorder returned no error")
+				}
+				if len(updates) != 0 {
+					t.Errorf("Reorder updated %v despite error", updates)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Reorder returned error: %v", err)
+			}
+			if !reflect.DeepEqual(updates, tt.want) {
+				t.Errorf("Reorder updated sort keys %v, want %v", updates, tt.want)
+			}
+		})
+	}
+}
+
+func TestCustomFieldOptionsService_Reorder_UsesSortKeyOrder(t *testing.T) {
+	// Options are listed in a different order than their sort keys.
+	options := []CustomFieldOption{
+		{Id: "b", Value: "B", SortKey: 20},
+		{Id: "a", Value: "A", SortKey: 10},
+		{Id: "c", Value: "C", SortKey: 5},
+	}
+	updates := map[string]int64{}
+	c := newReorderTestClient(t, options, updates)
+
+	if _, err := c.CustomFieldOptions.Reorder(context.Background(), "cf_1", []string{"a"}); err != nil {
+		t.Fatalf("Reorder returned error: %v", err)
+	}
+	want := map[string]int64{"c": 20, "b": 30}
+	if !reflect.DeepEqual(updates, want) {
+		t.Errorf("Reorder updated sort keys %v, want %v", updates, want)
+	}
+}
+
+func TestCustomFieldOptionsService_Reorder_UpdateFails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			io.WriteString(w, `{"type":"validation_error","status":422}`)
+			return
+		}
+		io.WriteString(w, `{"custom_field_options":[{"id":"a","value":"A","sort_key":10},{"id":"b","value":"B","sort_key":20}]}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+
+	resp, err := c.CustomFieldOptions.Reorder(context.Background(), "cf_1", []string{"b", "a"})
+	if err == nil {
+		t.Fatal("Reorder returned no error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Reorder returned response %v, want the failed update with status 422", resp)
+	}
+}
+
+func TestCustomFieldOptionsService_Requests(t *testing.T) {
+	var req recordedRequest
+	c := newCustomFieldsTestClient(t, &req)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		call     func() error
+		wantPath string
+		wantBody map[string]interface{}
+	}{
+		{
+			name:     "Get",
+			call:     func() error { _, _, err := c.CustomFieldOptions.Get(ctx, "opt_1"); return err },
+			wantPath: "GET /v1/custom_field_options/opt_1",
+		},
+		{
+			name: "Create without sort key",
+			call: func() error {
+				_, _, err := c.CustomFieldOptions.Create(ctx, &CreateCustomFieldOptionRequest{CustomFieldID: "cf_1", Value: "Payments"})
+				return err
+			},
+			wantPath: "POST /v1/custom_field_options",
+			wantBody: map[string]interface{}{"custom_field_id": "cf_1", "value": "Payments"},
+		},
+		{
+			name: "Create with zero sort key",
+			call: func() error {
+				_, _, err := c.CustomFieldOptions.Create(ctx, &CreateCustomFieldOptionRequest{CustomFieldID: "cf_1", Value: "Payments", SortKey: Int64(0)})
+				return err
+			},
+			wantPath: "POST /v1/custom_field_options",
+			wantBody: map[string]interface{}{"custom_field_id": "cf_1", "value": "Payments", "sort_key": float64(0)},
+		},
+		{
+			name: "Update without sort key",
+			call: func() error {
+				_, _, err := c.CustomFieldOptions.Update(ctx, "opt_1", &UpdateCustomFieldOptionRequest{Value: "Billing"})
+				return err
+			},
+			wantPath: "PUT /v1/custom_field_options/opt_1",
+			wantBody: map[string]interface{}{"value": "Billing"},
+		},
+		{
+			name: "Update with sort key",
+			call: func() error {
+				_, _, err := c.CustomFieldOptions.Update(ctx, "opt_1", &UpdateCustomFieldOptionRequest{Value: "Billing", SortKey: Int64(30)})
+				return err
+			},
+			wantPath: "PUT /v1/custom_field_options/opt_1",
+			wantBody: map[string]interface{}{"value": "Billing", "sort_key": float64(30)},
+		},
+		{
+			name:     "Delete",
+			call:     func() error { _, err := c.CustomFieldOptions.Delete(ctx, "opt_1"); return err },
+			wantPath: "DELETE /v1/custom_field_options/opt_1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Fatalf("returned error: %v", err)
+			}
+			if got := req.method + " " + req.path; got != tt.wantPath {
+				t.Errorf("sent %s, want %s", got, tt.wantPath)
+			}
+			if !reflect.DeepEqual(req.body, tt.wantBody) {
+				t.Errorf("sent body %v, want %v", req.body, tt.wantBody)
+			}
+		})
+	}
+}
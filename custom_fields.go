@@ -53,3 +53,81 @@ func (s *CustomFieldsService) Get(ctx context.Context, id string) (*CustomFieldR
 
 	return v, resp, nil
 }
+
+// NewEntriesBuilder lists all custom fields and returns a
+// CustomFieldEntriesBuilder to set their values on an incident.
+// See LoadCustomFieldEntriesBuilder.
+func (s *CustomFieldsService) NewEntriesBuilder(ctx context.Context) (*CustomFieldEntriesBuilder, *Response, error) {
+	return LoadCustomFieldEntriesBuilder(ctx, s)
+}
+
+// LoadCustomFieldEntriesBuilder lists all custom fields with api.List and
+// returns a CustomFieldEntriesBuilder to set their values on an incident.
+func LoadCustomFieldEntriesBuilder(ctx context.Context, api CustomFieldsAPI) (*CustomFieldEntriesBuilder, *Response, error) {
+	fields, resp, err := api.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return NewCustomFieldEntriesBuilder(fields.CustomFields), resp, nil
+}
+
+// Create creates a new custom field.
+// Options of select fields are managed with CustomFieldOptionsService.
+//
+// API docs: https://api-docs.incident.io/#operation/Custom%20Fields_Create
+func (s *CustomFieldsService) Create(ctx context.Context, opts *CreateCustomFieldRequest) (*CustomFieldResponse, *Response, error) {
+	u := "custom_fields"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CustomFieldResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Update updates an existing custom field.
+// The type of a custom field can't be changed.
+//
+// id represents the unique identifier for the custom field
+//
+// API docs: https://api-docs.incident.io/#operation/Custom%20Fields_Update
+func (s *CustomFieldsService) Update(ctx context.Context, id string, opts *UpdateCustomFieldRequest) (*CustomFieldResponse, *Response, error) {
+	u := fmt.Sprintf("custom_fields/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &CustomFieldResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Delete deletes a custom field.
+//
+// id represents the unique identifier for the custom field
+//
+// API docs: https://api-docs.incident.io/#operation/Custom%20Fields_Delete
+func (s *CustomFieldsService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("custom_fields/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
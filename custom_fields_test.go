@@ -0,0 +1,110 @@
+package incident
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// newCustomFieldsTestClient returns a client for a server that records
+// the last request in req and responds with an empty object.
+func newCustomFieldsTestClient(t *testing.T, req *recordedRequest) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*req = recordedRequest{method: r.Method, path: r.URL.Path}
+		if r.Method == http.MethodPost || r.Method == http.MethodPut {
+			json.NewDecoder(r.Body).Decode(&req.body)
+		}
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+	return c
+}
+
+func TestCustomFieldsService_Requests(t *testing.T) {
+	var req recordedRequest
+	c := newCustomFieldsTestClient(t, &req)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		call     func() error
+		wantPath string
+		wantBody map[string]interface{}
+	}{
+		{
+			name: "Create",
+			call: func() error {
+				_, _, err := c.CustomFields.Create(ctx, &CreateCustomFieldRequest{
+					Name:               "Affected team",
+					Description:        "The team owning the incident",
+					FieldType:          "single_select",
+					ShowBeforeCreation: true,
+				})
+				return err
+			},
+			wantPath: "POST /v1/custom_fields",
+			wantBody: map[string]interface{}{
+				"name":                    "Affected team",
+				"description":             "The team owning the incident",
+				"field_type":              "single_select",
+				"require_before_closure":  false,
+				"require_before_creation": false,
+				"show_before_closure":     false,
+				"show_before_creation":    true,
+			},
+		},
+		{
+			name: "Update",
+			call: func() error {
+				_, _, err := c.CustomFields.Update(ctx, "cf_1", &UpdateCustomFieldRequest{
+					Name:                 "Team",
+					Description:          "The team owning the incident",
+					RequireBeforeClosure: true,
+				})
+				return err
+			},
+			wantPath: "PUT /v1/custom_fields/cf_1",
+			wantBody: map[string]interface{}{
+				"name":                    "Team",
+				"description":             "The team owning the incident",
+				"require_before_closure":  true,
+				"require_before_creation": false,
+				"show_before_closure":     false,
+				"show_before_creation":    false,
+			},
+		},
+		{
+			name:     "Delete",
+			call:     func() error { _, err := c.CustomFields.Delete(ctx, "cf_1"); return err },
+			wantPath: "DELETE /v1/custom_fields/cf_1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Fatalf("returned error: %v", err)
+			}
+			if got := req.method + " " + req.path; got != tt.wantPath {
+				t.Errorf("sent %s, want %s", got, tt.wantPath)
+			}
+			if !reflect.DeepEqual(req.body, tt.wantBody) {
+				t.Errorf("sent body %v, want %v", req.body, tt.wantBody)
+			}
+		})
+	}
+}
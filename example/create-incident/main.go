@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/andygrunwald/go-incident"
+)
+
+func main() {
+	apiKey := os.Getenv("INCIDENT_IO_API_KEY")
+	client := incident.NewClient(apiKey, nil)
+
+	// Create an incident.
+	// Retrying with the same idempotency key will not open a second incident.
+	opt := &incident.CreateIncidentRequest{
+		IdempotencyKey: "<unique-key-of-the-alert>",
+		Name:           "Our database is on fire",
+		Summary:        "The primary database stopped accepting connections.",
+		SeverityID:     "<Severity-ID>",
+		Mode:           incident.IncidentTypeTest,
+		Visibility:     incident.IncidentVisibilityPublic,
+	}
+	v, resp, err := client.Incidents.Create(context.Background(), opt)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Response: %v\n", resp.Status)
+
+	fmt.Println(v.Incident.Id, v.Incident.Reference, v.Incident.Name)
+}
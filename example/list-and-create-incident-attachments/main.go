@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/andygrunwald/go-incident"
+)
+
+func main() {
+	apiKey := os.Getenv("INCIDENT_IO_API_KEY")
+	client := incident.NewClient(apiKey, nil)
+
+	// Attach a PagerDuty incident to an incident
+	v, resp, err := client.IncidentAttachments.Create(context.Background(), &incident.CreateIncidentAttachmentRequest{
+		IncidentID: "<Incident-ID>",
+		Resource: incident.ExternalResourceReference{
+			ExternalID:   "<PagerDuty-Incident-ID>",
+			ResourceType: incident.IncidentAttachmentResourceTypePagerDutyIncident,
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Response: %v\n", resp.Status)
+	fmt.Println(v.IncidentAttachment.ID)
+
+	fmt.Println("========================")
+
+	// List the attachments of the incident
+	list, resp, err := client.IncidentAttachments.List(context.Background(), &incident.IncidentAttachmentsListOptions{
+		IncidentID: "<Incident-ID>",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Response: %v\n", resp.Status)
+
+	for _, a := range list.IncidentAttachments {
+		fmt.Println(a.ID, a.Resource.ResourceType, a.Resource.Title, a.Resource.Permalink)
+	}
+
+	// Remove the attachment again
+	_, err = client.IncidentAttachments.Delete(context.Background(), v.IncidentAttachment.ID)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/andygrunwald/go-incident"
+)
+
+func main() {
+	apiKey := os.Getenv("INCIDENT_IO_API_KEY")
+	client := incident.NewClient(apiKey, nil)
+
+	// List alerts that fired during the last day
+	opt := &incident.AlertsListOptions{
+		PageSize:     25,
+		Status:       []string{incident.AlertStatusFiring},
+		CreatedAfter: time.Now().Add(-24 * time.Hour),
+	}
+	v, resp, err := client.Alerts.List(context.Background(), opt)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Response: %v\n", resp.Status)
+
+	for _, v := range v.Alerts {
+		fmt.Println(v.ID, v.Title, v.Status)
+	}
+
+	fmt.Println("========================")
+
+	// List the incidents a single alert was attached to
+	v1, resp, err := client.Alerts.ListIncidentAlerts(context.Background(), &incident.IncidentAlertsListOptions{
+		AlertID: "<Alert-ID>",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Response: %v\n", resp.Status)
+
+	for _, v := range v1.IncidentAlerts {
+		fmt.Println(v.Incident.Reference, v.Incident.Name)
+	}
+}
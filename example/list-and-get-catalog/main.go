@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/andygrunwald/go-incident"
+)
+
+func main() {
+	apiKey := os.Getenv("INCIDENT_IO_API_KEY")
+	client := incident.NewClient(apiKey, nil)
+
+	// List catalog types
+	v, resp, err := client.Catalog.ListTypes(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Response: %v\n", resp.Status)
+
+	for _, v := range v.CatalogTypes {
+		fmt.Println(v.ID, v.Name, v.TypeName)
+	}
+
+	fmt.Println("========================")
+
+	// List all entries of a catalog type
+	opt := &incident.CatalogEntriesListOptions{
+		CatalogTypeID: "<Catalog-Type-ID>",
+		PageSize:      25,
+	}
+	err = client.Catalog.ListAllEntries(context.Background(), opt, func(e incident.CatalogEntry) error {
+		fmt.Println(e.ID, e.Name, e.ExternalID, e.Aliases)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
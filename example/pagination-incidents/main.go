@@ -20,25 +20,14 @@ func main() {
 		},
 	}
 
-	// get all pages of results
-	var allIncidents []incident.Incident
-	for {
-		fmt.Printf("Requesting incidents - After ID: %s\n", opt.After)
-		incidents, _, err := client.Incidents.List(context.Background(), opt)
-		if err != nil {
-			panic(err)
-		}
-
-		allIncidents = append(allIncidents, incidents.Incidents...)
-		fmt.Printf("Appended %d items, in total now %d\n", len(incidents.Incidents), len(allIncidents))
-
-		if incidents.PaginationMeta.TotalRecordCount == int64(len(allIncidents)) {
-			break
-		}
-		opt.After = incidents.Incidents[len(incidents.Incidents)-1].Id
-	}
-
-	for _, v := range allIncidents {
+	// iterate over all pages of results.
+	// Pages are requested on demand, following the After cursor.
+	it := client.Incidents.Iter(opt)
+	for it.Next(context.Background()) {
+		v := it.Incident()
 		fmt.Println(v.Id, v.Name, v.PostmortemDocumentUrl)
 	}
+	if err := it.Err(); err != nil {
+		panic(err)
+	}
 }
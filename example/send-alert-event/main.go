@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/andygrunwald/go-incident"
+)
+
+func main() {
+	// HTTP alert sources authenticate with their own token
+	token := os.Getenv("INCIDENT_IO_ALERT_SOURCE_TOKEN")
+	client := incident.NewClient("", nil)
+
+	// Raise an alert
+	event := &incident.AlertEvent{
+		Title:            "High error rate on payments API",
+		Description:      "More than 5% of requests failed in the last 5 minutes.",
+		Status:           incident.AlertEventStatusFiring,
+		DeduplicationKey: "payments-api-error-rate",
+		Metadata: map[string]interface{}{
+			"service": "payments-api",
+		},
+		SourceURL: "https://grafana.example.com/d/payments",
+	}
+	v, resp, err := client.AlertEvents.Create(context.Background(), "<Alert-Source-Config-ID>", token, event)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Response: %v\n", resp.Status)
+	fmt.Println(v.Status, v.Message, v.DeduplicationKey)
+
+	// Resolve the alert again, by using the same deduplication key
+	event.Status = incident.AlertEventStatusResolved
+	_, _, err = client.AlertEvents.Create(context.Background(), "<Alert-Source-Config-ID>", token, event)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/andygrunwald/go-incident"
+)
+
+func main() {
+	apiKey := os.Getenv("INCIDENT_IO_API_KEY")
+	client := incident.NewClient(apiKey, nil)
+
+	// Desired state of the catalog type, e.g. read from a file in git
+	desired := []incident.DesiredCatalogEntry{
+		{Name: "Payments API", ExternalID: "payments-api", Aliases: []string{"payments"}},
+		{Name: "Checkout", ExternalID: "checkout"},
+	}
+
+	// Compute the plan first, without changing anything
+	opt := &incident.CatalogSyncOptions{
+		DryRun: true,
+		Prune:  true,
+	}
+	plan, err := incident.SyncCatalogEntries(context.Background(), client.Catalog, "<Catalog-Type-ID>", desired, opt)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, v := range plan.Create {
+		fmt.Println("create", v.ExternalID, v.Name)
+	}
+	for _, v := range plan.Update {
+		fmt.Println("update", v.Current.ID, v.Desired.Name)
+	}
+	for _, v := range plan.Delete {
+		fmt.Println("delete", v.ID, v.Name)
+	}
+	fmt.Printf("%d entries unchanged\n", plan.Unchanged)
+
+	// Execute the plan
+	if err := plan.Apply(context.Background(), client.Catalog); err != nil {
+		panic(err)
+	}
+}
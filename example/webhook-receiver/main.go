@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/andygrunwald/go-incident/webhook"
+)
+
+func main() {
+	secret := os.Getenv("INCIDENT_IO_WEBHOOK_SECRET")
+	if secret == "" {
+		log.Fatal("INCIDENT_IO_WEBHOOK_SECRET is not set")
+	}
+
+	h := &webhook.Handler{
+		Secret: secret,
+		OnIncidentCreated: func(ctx context.Context, e *webhook.Event) error {
+			log.Printf("Incident created: %s %s", e.Incident.Reference, e.Incident.Name)
+			return nil
+		},
+		OnIncidentUpdated: func(ctx context.Context, e *webhook.Event) error {
+			log.Printf("Incident updated: %s %s (%s)", e.Incident.Reference, e.Incident.Name, e.Incident.Status)
+			return nil
+		},
+		OnEvent: func(ctx context.Context, e *webhook.Event) error {
+			log.Printf("Received event %s", e.Type)
+			return nil
+		},
+	}
+
+	http.Handle("/webhook", h)
+	log.Fatal(http.ListenAndServe(":8080", nil))
+}
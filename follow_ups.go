@@ -0,0 +1,92 @@
+package incident
+
+import (
+	"context"
+	"fmt"
+)
+
+// FollowUpsService handles communication with the follow-up related
+// methods of the Incident.io API.
+//
+// API docs: https://api-docs.incident.io/tag/Follow-ups-V2
+type FollowUpsService service
+
+// List list all follow-ups for an organisation.
+//
+// The API filters by incident and incident mode. The status and assignee
+// filters of opts are applied by the client on the returned follow-ups.
+//
+// API docs: https://api-docs.incident.io/tag/Follow-ups-V2#operation/Follow-ups%20V2_List
+func (s *FollowUpsService) List(ctx context.Context, opts *FollowUpsListOptions) (*FollowUpsList, *Response, error) {
+	u := apiV2Path("follow_ups")
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &FollowUpsList{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if opts != nil && (len(opts.Status) > 0 || opts.AssigneeID != "") {
+		filtered := make([]FollowUp, 0, len(v.FollowUps))
+		for _, f := range v.FollowUps {
+			if opts.matches(f) {
+				filtered = append(filtered, f)
+			}
+		}
+		v.FollowUps = filtered
+	}
+
+	return v, resp, nil
+}
+
+// Get returns a single follow-up.
+//
+// id represents the unique identifier for the follow-up
+//
+// API docs: https://api-docs.incident.io/tag/Follow-ups-V2#operation/Follow-ups%20V2_Show
+func (s *FollowUpsService) Get(ctx context.Context, id string) (*FollowUpResponse, *Response, error) {
+	u := apiV2Path(fmt.Sprintf("follow_ups/%s", id))
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &FollowUpResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// matches reports whether f passes the client side filters of opts.
+func (opts *FollowUpsListOptions) matches(f FollowUp) bool {
+	if len(opts.Status) > 0 {
+		var found bool
+		for _, s := range opts.Status {
+			if f.Status == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if opts.AssigneeID != "" && (f.Assignee == nil || f.Assignee.Id != opts.AssigneeID) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,59 @@
+package incident
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestFollowUpsService_List_Filters(t *testing.T) {
+	// The server doesn't support the status and assignee filters and always returns all follow-ups.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"follow_ups":[
+			{"id":"f1","status":"outstanding","assignee":{"id":"u1"}},
+			{"id":"f2","status":"completed","assignee":{"id":"u1"}},
+			{"id":"f3","status":"outstanding","assignee":{"id":"u2"}},
+			{"id":"f4","status":"not_doing"}
+		]}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+
+	tests := []struct {
+		name string
+		opts *FollowUpsListOptions
+		want []string
+	}{
+		{name: "no options", opts: nil, want: []string{"f1", "f2", "f3", "f4"}},
+		{name: "no filters", opts: &FollowUpsListOptions{IncidentID: "inc_1"}, want: []string{"f1", "f2", "f3", "f4"}},
+		{name: "single status", opts: &FollowUpsListOptions{Status: []string{FollowUpStatusCompleted}}, want: []string{"f2"}},
+		{name: "status list", opts: &FollowUpsListOptions{Status: []string{FollowUpStatusOutstanding, FollowUpStatusNotDoing}}, want: []string{"f1", "f3", "f4"}},
+		{name: "assignee", opts: &FollowUpsListOptions{AssigneeID: "u1"}, want: []string{"f1", "f2"}},
+		{name: "unknown assignee", opts: &FollowUpsListOptions{AssigneeID: "u9"}, want: []string{}},
+		{name: "status and assignee", opts: &FollowUpsListOptions{Status: []string{FollowUpStatusOutstanding}, AssigneeID: "u1"}, want: []string{"f1"}},
+		{name: "status of an unassigned follow-up and assignee", opts: &FollowUpsListOptions{Status: []string{FollowUpStatusNotDoing}, AssigneeID: "u1"}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, _, err := c.FollowUps.List(context.Background(), tt.opts)
+			if err != nil {
+				t.Fatalf("List returned error: %v", err)
+			}
+
+			got := make([]string, 0, len(list.FollowUps))
+			for _, f := range list.FollowUps {
+				got = append(got, f.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("List returned %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
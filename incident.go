@@ -13,6 +13,7 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -40,23 +41,53 @@ type Client struct {
 	// We only have a cloud version of the Incident.io API.
 	// However, we export it in case some companies run a Incident.io compatible API version.
 	// BaseURL should always be specified with a trailing slash.
+	// Prefer setting it via WithBaseURL, which validates it at construction.
 	BaseURL *url.URL
 
+	// BaseURL as validated by NewClientWithOptions. NewRequest only checks
+	// the trailing slash of BaseURL, if it was replaced after construction.
+	validatedBaseURL *url.URL
+
 	// API Key used for authentication against the API
 	apiKey string
 
 	// User agent used when communicating with the Incident.io API.
 	UserAgent string
 
+	// Policy to retry failed requests. Retries are disabled if nil.
+	retryPolicy *RetryPolicy
+
+	// Whether requests block until the rate limit resets.
+	waitForRateLimit bool
+
+	// Logger for requests, retries and rate limit waits. Nothing is logged if nil.
+	logger Logger
+
+	// rateMu protects rateLimit.
+	rateMu sync.Mutex
+	// Rate limit reported by the most recent API response.
+	rateLimit Rate
+
 	// Reuse a single struct instead of allocating one for each service on the heap.
 	common service
 
 	// Services used for talking to different parts of the Incident.io API.
-	Actions       *ActionsService
-	CustomFields  *CustomFieldsService
-	Severities    *SeveritiesService
-	IncidentRoles *IncidentRolesService
-	Incidents     *IncidentsService
+	Actions             *ActionsService
+	AlertEvents         *AlertEventsService
+	AlertSources        *AlertSourcesService
+	Alerts              *AlertsService
+	Catalog             *CatalogService
+	CustomFieldOptions  *CustomFieldOptionsService
+	CustomFields        *CustomFieldsService
+	FollowUps           *FollowUpsService
+	Severities          *SeveritiesService
+	IncidentAttachments *IncidentAttachmentsService
+	IncidentRoles       *IncidentRolesService
+	IncidentStatuses    *IncidentStatusesService
+	IncidentTypes       *IncidentTypesService
+	IncidentUpdates     *IncidentUpdatesService
+	Incidents           *IncidentsService
+	Users               *UsersService
 }
 
 type service struct {
@@ -80,14 +111,32 @@ func NewClient(apiKey string, httpClient *http.Client) *Client {
 	}
 	c.common.client = c
 	c.Actions = (*ActionsService)(&c.common)
+	c.AlertEvents = (*AlertEventsService)(&c.common)
+	c.AlertSources = (*AlertSourcesService)(&c.common)
+	c.Alerts = (*AlertsService)(&c.common)
+	c.Catalog = (*CatalogService)(&c.common)
+	c.CustomFieldOptions = (*CustomFieldOptionsService)(&c.common)
 	c.CustomFields = (*CustomFieldsService)(&c.common)
+	c.FollowUps = (*FollowUpsService)(&c.common)
 	c.Severities = (*SeveritiesService)(&c.common)
+	c.IncidentAttachments = (*IncidentAttachmentsService)(&c.common)
 	c.IncidentRoles = (*IncidentRolesService)(&c.common)
+	c.IncidentStatuses = (*IncidentStatusesService)(&c.common)
+	c.IncidentTypes = (*IncidentTypesService)(&c.common)
+	c.IncidentUpdates = (*IncidentUpdatesService)(&c.common)
 	c.Incidents = (*IncidentsService)(&c.common)
+	c.Users = (*UsersService)(&c.common)
 
 	return c
 }
 
+// logf logs a message, if a logger is configured.
+func (c *Client) logf(format string, v ...interface{}) {
+	if c.logger != nil {
+		c.logger.Printf(format, v...)
+	}
+}
+
 // Client returns the http.Client used by this Incident.io client.
 func (c *Client) Client() *http.Client {
 	c.clientMu.Lock()
@@ -118,13 +167,20 @@ func addOptions(s string, opts interface{}) (string, error) {
 	return u.String(), nil
 }
 
+// apiV2Path returns the relative URL of an endpoint that is only available
+// in version 2 of the API. BaseURL points to version 1 of the API, so version
+// 2 endpoints are resolved as a sibling of it.
+func apiV2Path(s string) string {
+	return "../v2/" + s
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the BaseURL of the Client.
 // Relative URLs should always be specified without a preceding slash. If
 // specified, the value pointed to by body is JSON encoded and included as the
 // request body.
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
-	if !strings.HasSuffix(c.BaseURL.Path, "/") {
+	if c.BaseURL != c.validatedBaseURL && !strings.HasSuffix(c.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
 	}
 
@@ -165,17 +221,21 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 }
 
 // Response is a Incident.io API response. This wraps the standard http.Response
-// returned from Incident.io. Right now, it only covers the native http response.
-// We wrap it to enable future extension like providing convenient access to things like
-// pagination information.
+// returned from Incident.io and provides convenient access to things like
+// rate limit information.
 type Response struct {
 	*http.Response
+
+	// Rate limit reported by the API for this response.
+	// Fields are left at their zero value if the API did not report them.
+	Rate Rate
 }
 
 // newResponse creates a new Response for the provided http.Response.
 // r must not be nil.
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
+	response.Rate = parseRate(r)
 	return response
 }
 
@@ -185,13 +245,55 @@ func newResponse(r *http.Response) *Response {
 //
 // The provided ctx must be non-nil, if it is nil an error is returned. If it is
 // canceled or times out, ctx.Err() will be returned.
+//
+// If a RetryPolicy is set, requests failing with a network error or a
+// retryable status code are sent again after a backoff. Requests hitting
+// the rate limit are not retried before the rate limit resets.
 func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, error) {
 	if ctx == nil {
 		return nil, errNonNilContext
 	}
 
+	// The retry mark lives in the context of req, which is replaced by ctx.
+	retryable := isMarkedRetryable(req)
 	req = req.WithContext(ctx)
 
+	policy := c.retryPolicyFor(req, retryable)
+	if policy == nil {
+		return c.bareDo(ctx, req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.bareDo(ctx, req)
+		if attempt >= policy.MaxAttempts || !policy.shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		// Don't retry before the rate limit resets, it would fail again.
+		d := policy.backoff(attempt)
+		if rateErr, ok := err.(*RateLimitError); ok {
+			if untilReset := time.Until(rateErr.Rate.Reset); untilReset > d {
+				d = untilReset
+			}
+		}
+
+		c.logf("go-incident: retrying %s %s in %v (attempt %d of %d): %v", req.Method, req.URL, d, attempt+1, policy.MaxAttempts, err)
+		// Return the last response, so the caller can inspect what failed.
+		if err := sleep(ctx, d); err != nil {
+			return resp, err
+		}
+		if err := rewindBody(req); err != nil {
+			return resp, err
+		}
+	}
+}
+
+// bareDo sends req exactly once. See BareDo.
+func (c *Client) bareDo(ctx context.Context, req *http.Request) (*Response, error) {
+	if err := c.waitForRateLimitReset(ctx); err != nil {
+		return nil, err
+	}
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
@@ -214,11 +316,18 @@ func (c *Client) BareDo(ctx context.Context, req *http.Request) (*Response, erro
 	}
 
 	response := newResponse(resp)
+	c.updateRateLimit(response.Rate)
+	c.logf("go-incident: %s %s: %s", req.Method, req.URL, resp.Status)
 
 	err = CheckResponse(resp)
 	if err != nil {
 		defer resp.Body.Close()
 	}
+	// Report the same rate limit on the error and the response. A relative
+	// Retry-After would otherwise resolve to slightly different times.
+	if rateErr, ok := err.(*RateLimitError); ok {
+		rateErr.Rate = response.Rate
+	}
 	return response, err
 }
 
@@ -255,9 +364,10 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 
 // CheckResponse checks the API response for errors, and returns them if
 // present. A response is considered an error if it has a status code outside
-// the 200 range or equal to 202 Accepted.
+// the 200 range.
 // API error responses are expected to have response
 // body, and a JSON response body that maps to ErrorResponse.
+// A 429 Too Many Requests response is returned as *RateLimitError.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; 200 <= c && c <= 299 {
 		return nil
@@ -269,6 +379,14 @@ func CheckResponse(r *http.Response) error {
 		json.Unmarshal(data, errorResponse)
 	}
 
+	if r.StatusCode == http.StatusTooManyRequests {
+		return &RateLimitError{
+			Rate:          parseRate(r),
+			Response:      r,
+			ErrorResponse: errorResponse,
+		}
+	}
+
 	return errorResponse
 }
 
@@ -314,3 +432,15 @@ func (e *Error) Error() string {
 type ErrorSource struct {
 	Field string `json:"field"`
 }
+
+// String is a helper routine that allocates a new string value
+// to store v and returns a pointer to it.
+func String(v string) *string { return &v }
+
+// Bool is a helper routine that allocates a new bool value
+// to store v and returns a pointer to it.
+func Bool(v bool) *bool { return &v }
+
+// Int64 is a helper routine that allocates a new int64 value
+// to store v and returns a pointer to it.
+func Int64(v int64) *int64 { return &v }
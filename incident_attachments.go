@@ -0,0 +1,76 @@
+package incident
+
+import (
+	"context"
+	"fmt"
+)
+
+// IncidentAttachmentsService handles communication with the incident attachment related
+// methods of the Incident.io API.
+//
+// Attachments link external resources, like a PagerDuty incident, a Sentry
+// issue or a GitHub pull request, to an incident.
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Attachments-V1
+type IncidentAttachmentsService service
+
+// List list incident attachments for an organisation.
+// Either the incident ID or the external ID of opts must be set.
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Attachments-V1#operation/Incident%20Attachments%20V1_List
+func (s *IncidentAttachmentsService) List(ctx context.Context, opts *IncidentAttachmentsListOptions) (*IncidentAttachmentsList, *Response, error) {
+	u := "incident_attachments"
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentAttachmentsList{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Create attaches an external resource to an incident.
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Attachments-V1#operation/Incident%20Attachments%20V1_Create
+func (s *IncidentAttachmentsService) Create(ctx context.Context, opts *CreateIncidentAttachmentRequest) (*IncidentAttachmentResponse, *Response, error) {
+	u := "incident_attachments"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentAttachmentResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Delete removes an attachment from an incident.
+//
+// id represents the unique identifier for the incident attachment
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Attachments-V1#operation/Incident%20Attachments%20V1_Delete
+func (s *IncidentAttachmentsService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("incident_attachments/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
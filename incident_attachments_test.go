@@ -0,0 +1,89 @@
+package incident
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestIncidentAttachmentsService_List(t *testing.T) {
+	var query url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"incident_attachments":[{"id":"att_1","incident_id":"inc_1","resource":{"external_id":"PD123","resource_type":"pager_duty_incident"}}]}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+
+	list, _, err := c.IncidentAttachments.List(context.Background(), &IncidentAttachmentsListOptions{
+		IncidentID:   "inc_1",
+		ExternalID:   "PD123",
+		ResourceType: IncidentAttachmentResourceTypePagerDutyIncident,
+	})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	want := url.Values{
+		"incident_id":   {"inc_1"},
+		"external_id":   {"PD123"},
+		"resource_type": {"pager_duty_incident"},
+	}
+	if !reflect.DeepEqual(query, want) {
+		t.Errorf("List sent query %v, want %v", query, want)
+	}
+	if len(list.IncidentAttachments) != 1 || list.IncidentAttachments[0].Resource.ExternalID != "PD123" {
+		t.Errorf("List returned %+v, want attachment of PD123", list.IncidentAttachments)
+	}
+}
+
+func TestIncidentAttachmentsService_Create(t *testing.T) {
+	var method, path string
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"incident_attachment":{"id":"att_1","incident_id":"inc_1"}}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+
+	got, _, err := c.IncidentAttachments.Create(context.Background(), &CreateIncidentAttachmentRequest{
+		IncidentID: "inc_1",
+		Resource: ExternalResourceReference{
+			ExternalID:   "PD123",
+			ResourceType: IncidentAttachmentResourceTypePagerDutyIncident,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	if method != http.MethodPost || path != "/v1/incident_attachments" {
+		t.Errorf("Create sent %s %s, want POST /v1/incident_attachments", method, path)
+	}
+	want := map[string]interface{}{
+		"incident_id": "inc_1",
+		"resource": map[string]interface{}{
+			"external_id":   "PD123",
+			"resource_type": "pager_duty_incident",
+		},
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("Create sent body %v, want %v", body, want)
+	}
+	if got.IncidentAttachment.ID != "att_1" {
+		t.Errorf("Create returned attachment %q, want att_1", got.IncidentAttachment.ID)
+	}
+}
@@ -0,0 +1,110 @@
+package incident
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CustomField returns the entry of the custom field named or identified by
+// field, or nil if the incident has no such entry.
+// Names are matched case-insensitively.
+func (i *Incident) CustomField(field string) *CustomFieldEntry {
+	for j := range i.CustomFieldEntries {
+		if i.CustomFieldEntries[j].CustomField.Id == field {
+			return &i.CustomFieldEntries[j]
+		}
+	}
+	for j := range i.CustomFieldEntries {
+		if strings.EqualFold(i.CustomFieldEntries[j].CustomField.Name, field) {
+			return &i.CustomFieldEntries[j]
+		}
+	}
+	return nil
+}
+
+// CustomFieldText returns the value of the text custom field named or
+// identified by field. ok is false if the field has no text value.
+func (i *Incident) CustomFieldText(field string) (value string, ok bool) {
+	e := i.CustomField(field)
+	if e == nil {
+		return "", false
+	}
+	for _, v := range e.Values {
+		if v.ValueText != "" {
+			return v.ValueText, true
+		}
+	}
+	return "", false
+}
+
+// CustomFieldLink returns the value of the link custom field named or
+// identified by field. ok is false if the field has no link value.
+func (i *Incident) CustomFieldLink(field string) (value string, ok bool) {
+	e := i.CustomField(field)
+	if e == nil {
+		return "", false
+	}
+	for _, v := range e.Values {
+		if v.ValueLink != "" {
+			return v.ValueLink, true
+		}
+	}
+	return "", false
+}
+
+// CustomFieldNumeric returns the parsed value of the numeric custom field
+// named or identified by field. ok is false if the field has no numeric
+// value, or the value is not a valid number.
+func (i *Incident) CustomFieldNumeric(field string) (value float64, ok bool) {
+	e := i.CustomField(field)
+	if e == nil {
+		return 0, false
+	}
+	for _, v := range e.Values {
+		if v.ValueNumeric == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(v.ValueNumeric, 64)
+		if err != nil {
+			return 0, false
+		}
+		return f, true
+	}
+	return 0, false
+}
+
+// CustomFieldOptions returns the selected options of the single or multi
+// select custom field named or identified by field.
+// It returns nil if no option is selected.
+func (i *Incident) CustomFieldOptions(field string) []CustomFieldOption {
+	e := i.CustomField(field)
+	if e == nil {
+		return nil
+	}
+
+	var options []CustomFieldOption
+	for _, v := range e.Values {
+		if v.ValueOption != nil {
+			options = append(options, *v.ValueOption)
+		}
+	}
+	return options
+}
+
+// CustomFieldCatalogEntries returns the catalog entries of the catalog-backed
+// custom field named or identified by field.
+// It returns nil if no catalog entry is set.
+func (i *Incident) CustomFieldCatalogEntries(field string) []CatalogEntry {
+	e := i.CustomField(field)
+	if e == nil {
+		return nil
+	}
+
+	var entries []CatalogEntry
+	for _, v := range e.Values {
+		if v.ValueCatalogEntry != nil {
+			entries = append(entries, *v.ValueCatalogEntry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,91 @@
+package incident
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIncident_CustomFieldAccessors(t *testing.T) {
+	i := &Incident{CustomFieldEntries: []CustomFieldEntry{
+		{
+			CustomField: CustomFieldTypeInfo{Id: "cf_text", Name: "Notes", FieldType: CustomFieldTypeText},
+			Values:      []CustomFieldValue{{ValueText: "by name"}},
+		},
+		{
+			// Named like the ID of the entry above, to test that IDs take precedence.
+			CustomField: CustomFieldTypeInfo{Id: "cf_other", Name: "cf_text", FieldType: CustomFieldTypeText},
+			Values:      []CustomFieldValue{{ValueText: "shadowed"}},
+		},
+		{
+			CustomField: CustomFieldTypeInfo{Id: "cf_link", Name: "Runbook", FieldType: CustomFieldTypeLink},
+			Values:      []CustomFieldValue{{ValueLink: "https://example.com/runbook"}},
+		},
+		{
+			CustomField: CustomFieldTypeInfo{Id: "cf_num", Name: "Affected customers", FieldType: CustomFieldTypeNumeric},
+			Values:      []CustomFieldValue{{ValueNumeric: "42.5"}},
+		},
+		{
+			CustomField: CustomFieldTypeInfo{Id: "cf_bad_num", Name: "Broken number", FieldType: CustomFieldTypeNumeric},
+			Values:      []CustomFieldValue{{ValueNumeric: "many"}},
+		},
+		{
+			CustomField: CustomFieldTypeInfo{Id: "cf_multi", Name: "Products", FieldType: CustomFieldTypeMultiSelect},
+			Values: []CustomFieldValue{
+				{ValueOption: &CustomFieldOption{Id: "opt_api", Value: "API"}},
+				{ValueOption: &CustomFieldOption{Id: "opt_dashboard", Value: "Dashboard"}},
+			},
+		},
+		{
+			CustomField: CustomFieldTypeInfo{Id: "cf_catalog", Name: "Services", FieldType: CustomFieldTypeMultiSelect},
+			Values: []CustomFieldValue{
+				{ValueCatalogEntry: &CatalogEntry{ID: "entry_1"}},
+				{ValueCatalogEntry: &CatalogEntry{ID: "entry_2"}},
+			},
+		},
+		{
+			CustomField: CustomFieldTypeInfo{Id: "cf_empty", Name: "Empty", FieldType: CustomFieldTypeText},
+		},
+	}}
+
+	tests := []struct {
+		name string
+		get  func() interface{}
+		want interface{}
+	}{
+		{name: "entry by ID", get: func() interface{} { return i.CustomField("cf_link").CustomField.Id }, want: "cf_link"},
+		{name: "ID takes precedence over name", get: func() interface{} { return i.CustomField("cf_text").CustomField.Id }, want: "cf_text"},
+		{name: "name is case-insensitive", get: func() interface{} { return i.CustomField("affected CUSTOMERS").CustomField.Id }, want: "cf_num"},
+		{name: "missing entry", get: func() interface{} { return i.CustomField("Unknown") == nil }, want: true},
+
+		{name: "text", get: func() interface{} { v, ok := i.CustomFieldText("Notes"); return []interface{}{v, ok} }, want: []interface{}{"by name", true}},
+		{name: "text of missing field", get: func() interface{} { v, ok := i.CustomFieldText("Unknown"); return []interface{}{v, ok} }, want: []interface{}{"", false}},
+		{name: "text without value", get: func() interface{} { v, ok := i.CustomFieldText("Empty"); return []interface{}{v, ok} }, want: []interface{}{"", false}},
+		{name: "link", get: func() interface{} { v, ok := i.CustomFieldLink("Runbook"); return []interface{}{v, ok} }, want: []interface{}{"https://example.com/runbook", true}},
+		{name: "link of text field", get: func() interface{} { v, ok := i.CustomFieldLink("Notes"); return []interface{}{v, ok} }, want: []interface{}{"", false}},
+
+		{name: "numeric", get: func() interface{} { v, ok := i.CustomFieldNumeric("cf_num"); return []interface{}{v, ok} }, want: []interface{}{42.5, true}},
+		{name: "unparsable numeric", get: func() interface{} { v, ok := i.CustomFieldNumeric("Broken number"); return []interface{}{v, ok} }, want: []interface{}{0.0, false}},
+		{name: "numeric of missing field", get: func() interface{} { v, ok := i.CustomFieldNumeric("Unknown"); return []interface{}{v, ok} }, want: []interface{}{0.0, false}},
+
+		{
+			name: "multi select options",
+			get:  func() interface{} { return i.CustomFieldOptions("Products") },
+			want: []CustomFieldOption{{Id: "opt_api", Value: "API"}, {Id: "opt_dashboard", Value: "Dashboard"}},
+		},
+		{name: "options of missing field", get: func() interface{} { return i.CustomFieldOptions("Unknown") }, want: []CustomFieldOption(nil)},
+		{name: "options of text field", get: func() interface{} { return i.CustomFieldOptions("Notes") }, want: []CustomFieldOption(nil)},
+		{
+			name: "catalog entries",
+			get:  func() interface{} { return i.CustomFieldCatalogEntries("services") },
+			want: []CatalogEntry{{ID: "entry_1"}, {ID: "entry_2"}},
+		},
+		{name: "catalog entries of missing field", get: func() interface{} { return i.CustomFieldCatalogEntries("Unknown") }, want: []CatalogEntry(nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.get(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
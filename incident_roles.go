@@ -2,9 +2,14 @@ package incident
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
+// ErrLeadRoleNotDeletable is returned by IncidentRolesService.Delete when
+// trying to delete the built-in incident lead role.
+var ErrLeadRoleNotDeletable = errors.New("the incident lead role is built-in and can't be deleted")
+
 // IncidentRolesService handles communication with the incident roles related
 // methods of the Incident.io API.
 //
@@ -53,3 +58,72 @@ func (s *IncidentRolesService) Get(ctx context.Context, id string) (*IncidentRol
 
 	return v, resp, nil
 }
+
+// Create creates a new incident role.
+//
+// API docs: https://api-docs.incident.io/#operation/Incident%20Roles_Create
+func (s *IncidentRolesService) Create(ctx context.Context, opts *CreateIncidentRoleRequest) (*IncidentRoleResponse, *Response, error) {
+	u := "incident_roles"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentRoleResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Update updates an existing incident role.
+//
+// id represents the unique identifier for the role
+//
+// API docs: https://api-docs.incident.io/#operation/Incident%20Roles_Update
+func (s *IncidentRolesService) Update(ctx context.Context, id string, opts *UpdateIncidentRoleRequest) (*IncidentRoleResponse, *Response, error) {
+	u := fmt.Sprintf("incident_roles/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentRoleResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Delete deletes an incident role.
+//
+// The role is fetched first, to refuse deleting the built-in incident
+// lead role with ErrLeadRoleNotDeletable.
+//
+// id represents the unique identifier for the role
+//
+// API docs: https://api-docs.incident.io/#operation/Incident%20Roles_Delete
+func (s *IncidentRolesService) Delete(ctx context.Context, id string) (*Response, error) {
+	role, resp, err := s.Get(ctx, id)
+	if err != nil {
+		return resp, err
+	}
+	if role.IncidentRole.RoleType == IncidentRoleLead {
+		return resp, ErrLeadRoleNotDeletable
+	}
+
+	u := fmt.Sprintf("incident_roles/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,51 @@
+package incident_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/andygrunwald/go-incident"
+	"github.com/andygrunwald/go-incident/incidenttest"
+)
+
+func TestIncidentRolesService_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		roleType    string
+		wantErr     error
+		wantMethods []string
+	}{
+		{name: "lead role", roleType: incident.IncidentRoleLead, wantErr: incident.ErrLeadRoleNotDeletable, wantMethods: []string{http.MethodGet}},
+		{name: "custom role", roleType: incident.IncidentRoleCustom, wantMethods: []string{http.MethodGet, http.MethodDelete}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := incidenttest.NewServer()
+			defer srv.Close()
+			role := srv.AddIncidentRole(incident.IncidentRole{Name: "Role", RoleType: tt.roleType})
+
+			var methods []string
+			client := srv.Client(incident.WithMiddleware(func(next http.RoundTripper) http.RoundTripper {
+				return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+					methods = append(methods, r.Method)
+					return next.RoundTrip(r)
+				})
+			}))
+
+			_, err := client.IncidentRoles.Delete(context.Background(), role.Id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Delete returned error %v, want %v", err, tt.wantErr)
+			}
+			if !equalStrings(methods, tt.wantMethods) {
+				t.Errorf("Delete sent %v requests, want %v", methods, tt.wantMethods)
+			}
+
+			_, _, err = client.IncidentRoles.Get(context.Background(), role.Id)
+			if deleted := err != nil; deleted != (tt.wantErr == nil) {
+				t.Errorf("role deleted is %v, want %v", deleted, tt.wantErr == nil)
+			}
+		})
+	}
+}
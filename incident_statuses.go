@@ -0,0 +1,160 @@
+package incident
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrIncidentStatusNotFound is returned by IncidentStatusesService.ForIncident
+// if an incident has no incident status.
+var ErrIncidentStatusNotFound = errors.New("incident status not found")
+
+// IncidentStatusesService handles communication with the incident status related
+// methods of the Incident.io API.
+//
+// Organisations can configure their own incident statuses. Every status
+// belongs to a category, which describes its place in the incident lifecycle.
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Statuses-V1
+type IncidentStatusesService service
+
+// List list all incident statuses for an organisation.
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Statuses-V1#operation/Incident%20Statuses%20V1_List
+func (s *IncidentStatusesService) List(ctx context.Context) (*IncidentStatusesList, *Response, error) {
+	u := "incident_statuses"
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentStatusesList{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Get returns a single incident status.
+//
+// id represents the unique identifier for the incident status
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Statuses-V1#operation/Incident%20Statuses%20V1_Show
+func (s *IncidentStatusesService) Get(ctx context.Context, id string) (*IncidentStatusResponse, *Response, error) {
+	u := fmt.Sprintf("incident_statuses/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentStatusResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Create creates a new incident status.
+// Only statuses of the categories live, learning and closed can be created.
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Statuses-V1#operation/Incident%20Statuses%20V1_Create
+func (s *IncidentStatusesService) Create(ctx context.Context, opts *CreateIncidentStatusRequest) (*IncidentStatusResponse, *Response, error) {
+	u := "incident_statuses"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentStatusResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Update updates an existing incident status.
+// The category of a status can't be changed.
+//
+// id represents the unique identifier for the incident status
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Statuses-V1#operation/Incident%20Statuses%20V1_Update
+func (s *IncidentStatusesService) Update(ctx context.Context, id string, opts *UpdateIncidentStatusRequest) (*IncidentStatusResponse, *Response, error) {
+	u := fmt.Sprintf("incident_statuses/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentStatusResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Delete deletes an incident status.
+//
+// id represents the unique identifier for the incident status
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Statuses-V1#operation/Incident%20Statuses%20V1_Delete
+func (s *IncidentStatusesService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("incident_statuses/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ForIncident resolves the status of incident i into the configured
+// incident status, including its category.
+// See ResolveIncidentStatus.
+func (s *IncidentStatusesService) ForIncident(ctx context.Context, i *Incident) (*IncidentStatus, *Response, error) {
+	return ResolveIncidentStatus(ctx, s.client.Incidents, i)
+}
+
+// ResolveIncidentStatus resolves the status of incident i into the
+// configured incident status, including its category.
+//
+// Incident.Status only holds one of the fixed statuses of version 1 of the
+// API, like IncidentStatusFixing, so the status is taken from the incident
+// of version 2 of the API. If i already carries it, as returned by
+// IncidentsService.GetV2 and IncidentsService.Edit, no request is made.
+// Otherwise the incident is fetched with api.GetV2.
+// An incident without status must have an ID.
+// ErrIncidentStatusNotFound is returned if the incident has no status.
+func ResolveIncidentStatus(ctx context.Context, api IncidentsAPI, i *Incident) (*IncidentStatus, *Response, error) {
+	if i == nil {
+		return nil, nil, errors.New("incident must be non-nil")
+	}
+	if i.IncidentStatus != nil {
+		return i.IncidentStatus, nil, nil
+	}
+	if i.Id == "" {
+		return nil, nil, errors.New("incident ID must be set to resolve its status")
+	}
+
+	v, resp, err := api.GetV2(ctx, i.Id)
+	if err != nil {
+		return nil, resp, err
+	}
+	if v.Incident.IncidentStatus == nil {
+		return nil, resp, ErrIncidentStatusNotFound
+	}
+	return v.Incident.IncidentStatus, resp, nil
+}
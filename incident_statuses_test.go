@@ -0,0 +1,109 @@
+package incident_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/andygrunwald/go-incident"
+	"github.com/andygrunwald/go-incident/incidentmock"
+	"github.com/andygrunwald/go-incident/incidenttest"
+)
+
+func TestIncidentStatusesService_ForIncident(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+
+	waiting := &incident.IncidentStatus{ID: "st_2", Name: "Waiting for vendor", Category: "live"}
+	inc := srv.AddIncident(incident.Incident{Name: "Database is down", Status: incident.IncidentStatusFixing, IncidentStatus: waiting})
+
+	var requests int
+	client := srv.Client(incident.WithMiddleware(countRequests(&requests)))
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		incident     *incident.Incident
+		wantErr      bool
+		wantRequests int
+	}{
+		{
+			name:     "incident status already set",
+			incident: &incident.Incident{Id: inc.Id, Status: incident.IncidentStatusFixing, IncidentStatus: waiting},
+		},
+		{
+			// The v1 status "fixing" must not be matched by name.
+			name:         "custom status of v1 incident",
+			incident:     &incident.Incident{Id: inc.Id, Status: incident.IncidentStatusFixing},
+			wantRequests: 1,
+		},
+		{
+			name:         "unknown incident",
+			incident:     &incident.Incident{Id: "unknown", Status: incident.IncidentStatusFixing},
+			wantErr:      true,
+			wantRequests: 1,
+		},
+		{
+			name:     "incident status set without ID",
+			incident: &incident.Incident{IncidentStatus: waiting},
+		},
+		{
+			name:     "incident without ID",
+			incident: &incident.Incident{Status: incident.IncidentStatusFixing},
+			wantErr:  true,
+		},
+		{
+			name:    "nil incident",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = 0
+			got, _, err := client.IncidentStatuses.ForIncident(ctx, tt.incident)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ForIncident returned status %+v, want error", got)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("ForIncident returned error: %v", err)
+				}
+				if got.ID != waiting.ID || got.Category != waiting.Category {
+					t.Errorf("ForIncident returned status %+v, want %+v", got, waiting)
+				}
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("ForIncident sent %d requests, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestIncidentStatusesService_ForIncident_NoStatus(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	inc := srv.AddIncident(incident.Incident{Name: "Database is down"})
+
+	_, _, err := srv.Client().IncidentStatuses.ForIncident(context.Background(), &inc)
+	if !errors.Is(err, incident.ErrIncidentStatusNotFound) {
+		t.Errorf("ForIncident returned error %v, want %v", err, incident.ErrIncidentStatusNotFound)
+	}
+}
+
+func TestResolveIncidentStatus_Mock(t *testing.T) {
+	waiting := &incident.IncidentStatus{ID: "st_2", Name: "Waiting for vendor", Category: "live"}
+	incidents := &incidentmock.IncidentsAPI{
+		GetV2Func: func(ctx context.Context, id string) (*incident.IncidentResponse, *incident.Response, error) {
+			return &incident.IncidentResponse{Incident: incident.Incident{Id: id, IncidentStatus: waiting}}, nil, nil
+		},
+	}
+
+	got, _, err := incident.ResolveIncidentStatus(context.Background(), incidents, &incident.Incident{Id: "inc_1"})
+	if err != nil {
+		t.Fatalf("ResolveIncidentStatus returned error: %v", err)
+	}
+	if got.ID != waiting.ID {
+		t.Errorf("ResolveIncidentStatus returned status %+v, want %+v", got, waiting)
+	}
+}
@@ -0,0 +1,58 @@
+package incident
+
+import (
+	"context"
+	"fmt"
+)
+
+// IncidentTypesService handles communication with the incident type related
+// methods of the Incident.io API.
+//
+// Incident types are configured by the organisation, e.g. "Security" or
+// "Platform". Not to be confused with Incident.Type, which tells whether
+// an incident is real, a test or a tutorial.
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Types-V1
+type IncidentTypesService service
+
+// List list all incident types for an organisation.
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Types-V1#operation/Incident%20Types%20V1_List
+func (s *IncidentTypesService) List(ctx context.Context) (*IncidentTypesList, *Response, error) {
+	u := "incident_types"
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentTypesList{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Get returns a single incident type.
+//
+// id represents the unique identifier for the incident type
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Types-V1#operation/Incident%20Types%20V1_Show
+func (s *IncidentTypesService) Get(ctx context.Context, id string) (*IncidentTypeResponse, *Response, error) {
+	u := fmt.Sprintf("incident_types/%s", id)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentTypeResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
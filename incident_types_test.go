@@ -0,0 +1,82 @@
+package incident
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestIncidentTypesService(t *testing.T) {
+	var method, path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		const security = `{"id":"it_1","name":"Security","description":"Security incidents","is_default":true,"private_incidents_only":true,"create_in_triage":"always"}`
+		if path == "/v1/incident_types" {
+			io.WriteString(w, `{"incident_types":[`+security+`]}`)
+			return
+		}
+		io.WriteString(w, `{"incident_type":`+security+`}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+	ctx := context.Background()
+
+	want := IncidentType{
+		ID:                   "it_1",
+		Name:                 "Security",
+		Description:          "Security incidents",
+		IsDefault:            true,
+		PrivateIncidentsOnly: true,
+		CreateInTriage:       "always",
+	}
+
+	list, _, err := c.IncidentTypes.List(ctx)
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if method != http.MethodGet || path != "/v1/incident_types" {
+		t.Errorf("List sent %s %s, want GET /v1/incident_types", method, path)
+	}
+	if len(list.IncidentTypes) != 1 || list.IncidentTypes[0] != want {
+		t.Errorf("List returned %+v, want [%+v]", list.IncidentTypes, want)
+	}
+
+	got, _, err := c.IncidentTypes.Get(ctx, "it_1")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if method != http.MethodGet || path != "/v1/incident_types/it_1" {
+		t.Errorf("Get sent %s %s, want GET /v1/incident_types/it_1", method, path)
+	}
+	if got.IncidentType != want {
+		t.Errorf("Get returned %+v, want %+v", got.IncidentType, want)
+	}
+}
+
+func TestIncident_IncidentType(t *testing.T) {
+	var i Incident
+	if err := json.Unmarshal([]byte(`{"id":"inc_1","type":"real","incident_type":{"id":"it_1","name":"Security"}}`), &i); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if i.Type != "real" {
+		t.Errorf("Type = %q, want real", i.Type)
+	}
+	if i.IncidentType == nil || i.IncidentType.ID != "it_1" || i.IncidentType.Name != "Security" {
+		t.Errorf("IncidentType = %+v, want it_1 Security", i.IncidentType)
+	}
+
+	var without Incident
+	if err := json.Unmarshal([]byte(`{"id":"inc_2"}`), &without); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+	if without.IncidentType != nil {
+		t.Errorf("IncidentType = %+v without incident_type, want nil", without.IncidentType)
+	}
+}
@@ -0,0 +1,132 @@
+package incident
+
+import (
+	"context"
+	"sort"
+)
+
+// IncidentUpdatesService handles communication with the incident update related
+// methods of the Incident.io API.
+//
+// Incident updates are the entries of the incident timeline, which report
+// changes of status and severity along with a message for stakeholders.
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Updates-V2
+type IncidentUpdatesService service
+
+// List list incident updates for an organisation, optionally filtered by incident.
+//
+// API docs: https://api-docs.incident.io/tag/Incident-Updates-V2#operation/Incident%20Updates%20V2_List
+func (s *IncidentUpdatesService) List(ctx context.Context, opts *IncidentUpdatesListOptions) (*IncidentUpdatesList, *Response, error) {
+	u := apiV2Path("incident_updates")
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentUpdatesList{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Iter returns an iterator over all incident updates matching opts.
+// The iterator follows the After cursor of the API and fetches one page
+// at a time. opts is copied and not modified by the iterator.
+func (s *IncidentUpdatesService) Iter(opts *IncidentUpdatesListOptions) *IncidentUpdatesIterator {
+	return NewIncidentUpdatesIterator(s, opts)
+}
+
+// ListAll calls fn for every incident update matching opts, following all pages.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func (s *IncidentUpdatesService) ListAll(ctx context.Context, opts *IncidentUpdatesListOptions, fn func(IncidentUpdate) error) error {
+	return ListAllIncidentUpdates(ctx, s, opts, fn)
+}
+
+// IncidentUpdatesIterator iterates over the pages of IncidentUpdatesService.List.
+// Create one with IncidentUpdatesService.Iter.
+type IncidentUpdatesIterator struct {
+	pager *cursorPager[IncidentUpdate]
+}
+
+// NewIncidentUpdatesIterator returns an iterator over all incident updates matching opts,
+// that fetches the pages with api.List.
+// This is useful to iterate over incident updates of a mock IncidentUpdatesAPI.
+// opts is copied and not modified by the iterator.
+func NewIncidentUpdatesIterator(api IncidentUpdatesAPI, opts *IncidentUpdatesListOptions) *IncidentUpdatesIterator {
+	o := IncidentUpdatesListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	fetch := func(ctx context.Context, after string) ([]IncidentUpdate, *PaginationMeta, error) {
+		o.After = after
+		list, _, err := api.List(ctx, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+		return list.IncidentUpdates, list.PaginationMeta, nil
+	}
+	id := func(v IncidentUpdate) string { return v.ID }
+
+	return &IncidentUpdatesIterator{pager: newCursorPager(o.After, fetch, id)}
+}
+
+// ListAllIncidentUpdates calls fn for every incident update matching opts, following all pages
+// fetched with api.List.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func ListAllIncidentUpdates(ctx context.Context, api IncidentUpdatesAPI, opts *IncidentUpdatesListOptions, fn func(IncidentUpdate) error) error {
+	return forEach(ctx, NewIncidentUpdatesIterator(api, opts).pager, fn)
+}
+
+// Next advances the iterator to the next incident update, fetching the next page
+// if required. It returns false when there are no more incident updates or an error
+// occurred. Check Err after Next returned false.
+func (it *IncidentUpdatesIterator) Next(ctx context.Context) bool {
+	return it.pager.next(ctx)
+}
+
+// IncidentUpdate returns the current incident update.
+// It is only valid after a call to Next returned true.
+func (it *IncidentUpdatesIterator) IncidentUpdate() IncidentUpdate {
+	return it.pager.current
+}
+
+// Err returns the first error that occurred during iteration, if any.
+func (it *IncidentUpdatesIterator) Err() error {
+	return it.pager.err
+}
+
+// SetPreviousIncidentStatuses sets PreviousIncidentStatus of every update to the
+// NewIncidentStatus of the previous update of the same incident, ordered by CreatedAt.
+//
+// The API does not report the status before an update, so updates should contain
+// all updates of an incident, e.g. collected with ListAll. The first update of an
+// incident keeps a nil PreviousIncidentStatus. The order of updates is not changed.
+func SetPreviousIncidentStatuses(updates []IncidentUpdate) {
+	order := make([]int, len(updates))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return updates[order[a]].CreatedAt.Before(updates[order[b]].CreatedAt)
+	})
+
+	previous := map[string]*IncidentStatus{}
+	for _, i := range order {
+		u := &updates[i]
+		u.PreviousIncidentStatus = previous[u.IncidentID]
+		status := u.NewIncidentStatus
+		previous[u.IncidentID] = &status
+	}
+}
@@ -0,0 +1,95 @@
+package incident
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestIncidentUpdatesService_List(t *testing.T) {
+	var path string
+	var query url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, query = r.URL.Path, r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"incident_updates":[
+			{"id":"u2","incident_id":"inc_1","message":"Fix deployed","new_incident_status":{"id":"st_3","name":"Monitoring","category":"live","rank":3},"new_severity":{"id":"sev_2","name":"Minor","rank":1},"created_at":"2023-05-01T13:00:00Z"},
+			{"id":"u1","incident_id":"inc_1","new_incident_status":{"id":"st_1","name":"Investigating","category":"live","rank":1},"created_at":"2023-05-01T12:00:00Z"}
+		]}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+
+	list, _, err := c.IncidentUpdates.List(context.Background(), &IncidentUpdatesListOptions{IncidentID: "inc_1", PageSize: 2})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if path != "/v2/incident_updates" {
+		t.Errorf("List requested %s, want /v2/incident_updates", path)
+	}
+	wantQuery := url.Values{"incident_id": {"inc_1"}, "page_size": {"2"}}
+	if !reflect.DeepEqual(query, wantQuery) {
+		t.Errorf("List sent query %v, want %v", query, wantQuery)
+	}
+
+	if len(list.IncidentUpdates) != 2 {
+		t.Fatalf("List returned %d updates, want 2", len(list.IncidentUpdates))
+	}
+	u := list.IncidentUpdates[0]
+	wantStatus := IncidentStatus{ID: "st_3", Name: "Monitoring", Category: "live", Rank: 3}
+	if !reflect.DeepEqual(u.NewIncidentStatus, wantStatus) {
+		t.Errorf("NewIncidentStatus = %+v, want %+v", u.NewIncidentStatus, wantStatus)
+	}
+	if u.NewSeverity == nil || u.NewSeverity.Id != "sev_2" || u.NewSeverity.Name != "Minor" || u.NewSeverity.Rank != 1 {
+		t.Errorf("NewSeverity = %+v, want Minor (sev_2)", u.NewSeverity)
+	}
+	if u.PreviousIncidentStatus != nil {
+		t.Errorf("PreviousIncidentStatus = %+v, want nil", u.PreviousIncidentStatus)
+	}
+	if list.IncidentUpdates[1].NewSeverity != nil {
+		t.Errorf("NewSeverity of an update without severity change = %+v, want nil", list.IncidentUpdates[1].NewSeverity)
+	}
+}
+
+func TestSetPreviousIncidentStatuses(t *testing.T) {
+	start := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	investigating := IncidentStatus{ID: "st_1", Name: "Investigating"}
+	fixing := IncidentStatus{ID: "st_2", Name: "Fixing"}
+	monitoring := IncidentStatus{ID: "st_3", Name: "Monitoring"}
+
+	// The API lists the newest updates first.
+	updates := []IncidentUpdate{
+		{ID: "u4", IncidentID: "inc_1", NewIncidentStatus: monitoring, CreatedAt: start.Add(3 * time.Hour)},
+		{ID: "u3", IncidentID: "inc_2", NewIncidentStatus: fixing, CreatedAt: start.Add(2 * time.Hour)},
+		{ID: "u2", IncidentID: "inc_1", NewIncidentStatus: fixing, CreatedAt: start.Add(time.Hour)},
+		{ID: "u1", IncidentID: "inc_1", NewIncidentStatus: investigating, CreatedAt: start},
+	}
+	SetPreviousIncidentStatuses(updates)
+
+	want := map[string]string{
+		"u4": "st_2",
+		"u3": "",
+		"u2": "st_1",
+		"u1": "",
+	}
+	for _, u := range updates {
+		got := ""
+		if u.PreviousIncidentStatus != nil {
+			got = u.PreviousIncidentStatus.ID
+		}
+		if got != want[u.ID] {
+			t.Errorf("PreviousIncidentStatus of %s = %q, want %q", u.ID, got, want[u.ID])
+		}
+	}
+
+	if updates[0].ID != "u4" || updates[3].ID != "u1" {
+		t.Errorf("SetPreviousIncidentStatuses changed the order of updates")
+	}
+}
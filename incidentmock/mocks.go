@@ -0,0 +1,732 @@
+// Code generated by mockgen from interfaces.go. DO NOT EDIT.
+
+// Package incidentmock provides mock implementations of the interfaces of package incident.
+package incidentmock
+
+import (
+	"context"
+
+	incident "github.com/andygrunwald/go-incident"
+)
+
+// ActionsAPI is a mock implementation of incident.ActionsAPI.
+// Calling a method whose func field is not set panics.
+type ActionsAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context, opts *incident.ActionsListOptions) (*incident.ActionsList, *incident.Response, error)
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id string) (*incident.ActionResponse, *incident.Response, error)
+}
+
+var _ incident.ActionsAPI = (*ActionsAPI)(nil)
+
+// List calls ListFunc.
+func (m *ActionsAPI) List(ctx context.Context, opts *incident.ActionsListOptions) (*incident.ActionsList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: ActionsAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// Get calls GetFunc.
+func (m *ActionsAPI) Get(ctx context.Context, id string) (*incident.ActionResponse, *incident.Response, error) {
+	if m.GetFunc == nil {
+		panic("incidentmock: ActionsAPI.Get called, but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// AlertEventsAPI is a mock implementation of incident.AlertEventsAPI.
+// Calling a method whose func field is not set panics.
+type AlertEventsAPI struct {
+	// CreateFunc implements Create.
+	CreateFunc func(ctx context.Context, alertSourceConfigID string, token string, event *incident.AlertEvent) (*incident.AlertEventResponse, *incident.Response, error)
+}
+
+var _ incident.AlertEventsAPI = (*AlertEventsAPI)(nil)
+
+// Create calls CreateFunc.
+func (m *AlertEventsAPI) Create(ctx context.Context, alertSourceConfigID string, token string, event *incident.AlertEvent) (*incident.AlertEventResponse, *incident.Response, error) {
+	if m.CreateFunc == nil {
+		panic("incidentmock: AlertEventsAPI.Create called, but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, alertSourceConfigID, token, event)
+}
+
+// AlertSourcesAPI is a mock implementation of incident.AlertSourcesAPI.
+// Calling a method whose func field is not set panics.
+type AlertSourcesAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context) (*incident.AlertSourcesList, *incident.Response, error)
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id string) (*incident.AlertSourceResponse, *incident.Response, error)
+}
+
+var _ incident.AlertSourcesAPI = (*AlertSourcesAPI)(nil)
+
+// List calls ListFunc.
+func (m *AlertSourcesAPI) List(ctx context.Context) (*incident.AlertSourcesList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: AlertSourcesAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx)
+}
+
+// Get calls GetFunc.
+func (m *AlertSourcesAPI) Get(ctx context.Context, id string) (*incident.AlertSourceResponse, *incident.Response, error) {
+	if m.GetFunc == nil {
+		panic("incidentmock: AlertSourcesAPI.Get called, but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// AlertsAPI is a mock implementation of incident.AlertsAPI.
+// Calling a method whose func field is not set panics.
+type AlertsAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context, opts *incident.AlertsListOptions) (*incident.AlertsList, *incident.Response, error)
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id string) (*incident.AlertResponse, *incident.Response, error)
+	// ListIncidentAlertsFunc implements ListIncidentAlerts.
+	ListIncidentAlertsFunc func(ctx context.Context, opts *incident.IncidentAlertsListOptions) (*incident.IncidentAlertsList, *incident.Response, error)
+}
+
+var _ incident.AlertsAPI = (*AlertsAPI)(nil)
+
+// List calls ListFunc.
+func (m *AlertsAPI) List(ctx context.Context, opts *incident.AlertsListOptions) (*incident.AlertsList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: AlertsAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// Get calls GetFunc.
+func (m *AlertsAPI) Get(ctx context.Context, id string) (*incident.AlertResponse, *incident.Response, error) {
+	if m.GetFunc == nil {
+		panic("incidentmock: AlertsAPI.Get called, but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// ListIncidentAlerts calls ListIncidentAlertsFunc.
+func (m *AlertsAPI) ListIncidentAlerts(ctx context.Context, opts *incident.IncidentAlertsListOptions) (*incident.IncidentAlertsList, *incident.Response, error) {
+	if m.ListIncidentAlertsFunc == nil {
+		panic("incidentmock: AlertsAPI.ListIncidentAlerts called, but ListIncidentAlertsFunc is not set")
+	}
+	return m.ListIncidentAlertsFunc(ctx, opts)
+}
+
+// CatalogAPI is a mock implementation of incident.CatalogAPI.
+// Calling a method whose func field is not set panics.
+type CatalogAPI struct {
+	// ListTypesFunc implements ListTypes.
+	ListTypesFunc func(ctx context.Context) (*incident.CatalogTypesList, *incident.Response, error)
+	// GetTypeFunc implements GetType.
+	GetTypeFunc func(ctx context.Context, id string) (*incident.CatalogTypeResponse, *incident.Response, error)
+	// CreateTypeFunc implements CreateType.
+	CreateTypeFunc func(ctx context.Context, opts *incident.CreateCatalogTypeRequest) (*incident.CatalogTypeResponse, *incident.Response, error)
+	// UpdateTypeFunc implements UpdateType.
+	UpdateTypeFunc func(ctx context.Context, id string, opts *incident.UpdateCatalogTypeRequest) (*incident.CatalogTypeResponse, *incident.Response, error)
+	// UpdateTypeSchemaFunc implements UpdateTypeSchema.
+	UpdateTypeSchemaFunc func(ctx context.Context, id string, opts *incident.UpdateCatalogTypeSchemaRequest) (*incident.CatalogTypeResponse, *incident.Response, error)
+	// DeleteTypeFunc implements DeleteType.
+	DeleteTypeFunc func(ctx context.Context, id string) (*incident.Response, error)
+	// ListEntriesFunc implements ListEntries.
+	ListEntriesFunc func(ctx context.Context, opts *incident.CatalogEntriesListOptions) (*incident.CatalogEntriesList, *incident.Response, error)
+	// GetEntryFunc implements GetEntry.
+	GetEntryFunc func(ctx context.Context, id string) (*incident.CatalogEntryResponse, *incident.Response, error)
+	// CreateEntryFunc implements CreateEntry.
+	CreateEntryFunc func(ctx context.Context, opts *incident.CreateCatalogEntryRequest) (*incident.CatalogEntryResponse, *incident.Response, error)
+	// UpdateEntryFunc implements UpdateEntry.
+	UpdateEntryFunc func(ctx context.Context, id string, opts *incident.UpdateCatalogEntryRequest) (*incident.CatalogEntryResponse, *incident.Response, error)
+	// DeleteEntryFunc implements DeleteEntry.
+	DeleteEntryFunc func(ctx context.Context, id string) (*incident.Response, error)
+}
+
+var _ incident.CatalogAPI = (*CatalogAPI)(nil)
+
+// ListTypes calls ListTypesFunc.
+func (m *CatalogAPI) ListTypes(ctx context.Context) (*incident.CatalogTypesList, *incident.Response, error) {
+	if m.ListTypesFunc == nil {
+		panic("incidentmock: CatalogAPI.ListTypes called, but ListTypesFunc is not set")
+	}
+	return m.ListTypesFunc(ctx)
+}
+
+// GetType calls GetTypeFunc.
+func (m *CatalogAPI) GetType(ctx context.Context, id string) (*incident.CatalogTypeResponse, *incident.Response, error) {
+	if m.GetTypeFunc == nil {
+		panic("incidentmock: CatalogAPI.GetType called, but GetTypeFunc is not set")
+	}
+	return m.GetTypeFunc(ctx, id)
+}
+
+// CreateType calls CreateTypeFunc.
+func (m *CatalogAPI) CreateType(ctx context.Context, opts *incident.CreateCatalogTypeRequest) (*incident.CatalogTypeResponse, *incident.Response, error) {
+	if m.CreateTypeFunc == nil {
+		panic("incidentmock: CatalogAPI.CreateType called, but CreateTypeFunc is not set")
+	}
+	return m.CreateTypeFunc(ctx, opts)
+}
+
+// UpdateType calls UpdateTypeFunc.
+func (m *CatalogAPI) UpdateType(ctx context.Context, id string, opts *incident.UpdateCatalogTypeRequest) (*incident.CatalogTypeResponse, *incident.Response, error) {
+	if m.UpdateTypeFunc == nil {
+		panic("incidentmock: CatalogAPI.UpdateType called, but UpdateTypeFunc is not set")
+	}
+	return m.UpdateTypeFunc(ctx, id, opts)
+}
+
+// UpdateTypeSchema calls UpdateTypeSchemaFunc.
+func (m *CatalogAPI) UpdateTypeSchema(ctx context.Context, id string, opts *incident.UpdateCatalogTypeSchemaRequest) (*incident.CatalogTypeResponse, *incident.Response, error) {
+	if m.UpdateTypeSchemaFunc == nil {
+		panic("incidentmock: CatalogAPI.UpdateTypeSchema called, but UpdateTypeSchemaFunc is not set")
+	}
+	return m.UpdateTypeSchemaFunc(ctx, id, opts)
+}
+
+// DeleteType calls DeleteTypeFunc.
+func (m *CatalogAPI) DeleteType(ctx context.Context, id string) (*incident.Response, error) {
+	if m.DeleteTypeFunc == nil {
+		panic("incidentmock: CatalogAPI.DeleteType called, but DeleteTypeFunc is not set")
+	}
+	return m.DeleteTypeFunc(ctx, id)
+}
+
+// ListEntries calls ListEntriesFunc.
+func (m *CatalogAPI) ListEntries(ctx context.Context, opts *incident.CatalogEntriesListOptions) (*incident.CatalogEntriesList, *incident.Response, error) {
+	if m.ListEntriesFunc == nil {
+		panic("incidentmock: CatalogAPI.ListEntries called, but ListEntriesFunc is not set")
+	}
+	return m.ListEntriesFunc(ctx, opts)
+}
+
+// GetEntry calls GetEntryFunc.
+func (m *CatalogAPI) GetEntry(ctx context.Context, id string) (*incident.CatalogEntryResponse, *incident.Response, error) {
+	if m.GetEntryFunc == nil {
+		panic("incidentmock: CatalogAPI.GetEntry called, but GetEntryFunc is not set")
+	}
+	return m.GetEntryFunc(ctx, id)
+}
+
+// CreateEntry calls CreateEntryFunc.
+func (m *CatalogAPI) CreateEntry(ctx context.Context, opts *incident.CreateCatalogEntryRequest) (*incident.CatalogEntryResponse, *incident.Response, error) {
+	if m.CreateEntryFunc == nil {
+		panic("incidentmock: CatalogAPI.CreateEntry called, but CreateEntryFunc is not set")
+	}
+	return m.CreateEntryFunc(ctx, opts)
+}
+
+// UpdateEntry calls UpdateEntryFunc.
+func (m *CatalogAPI) UpdateEntry(ctx context.Context, id string, opts *incident.UpdateCatalogEntryRequest) (*incident.CatalogEntryResponse, *incident.Response, error) {
+	if m.UpdateEntryFunc == nil {
+		panic("incidentmock: CatalogAPI.UpdateEntry called, but UpdateEntryFunc is not set")
+	}
+	return m.UpdateEntryFunc(ctx, id, opts)
+}
+
+// DeleteEntry calls DeleteEntryFunc.
+func (m *CatalogAPI) DeleteEntry(ctx context.Context, id string) (*incident.Response, error) {
+	if m.DeleteEntryFunc == nil {
+		panic("incidentmock: CatalogAPI.DeleteEntry called, but DeleteEntryFunc is not set")
+	}
+	return m.DeleteEntryFunc(ctx, id)
+}
+
+// CustomFieldOptionsAPI is a mock implementation of incident.CustomFieldOptionsAPI.
+// Calling a method whose func field is not set panics.
+type CustomFieldOptionsAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context, opts *incident.CustomFieldOptionsListOptions) (*incident.CustomFieldOptionsList, *incident.Response, error)
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id string) (*incident.CustomFieldOptionResponse, *incident.Response, error)
+	// CreateFunc implements Create.
+	CreateFunc func(ctx context.Context, opts *incident.CreateCustomFieldOptionRequest) (*incident.CustomFieldOptionResponse, *incident.Response, error)
+	// UpdateFunc implements Update.
+	UpdateFunc func(ctx context.Context, id string, opts *incident.UpdateCustomFieldOptionRequest) (*incident.CustomFieldOptionResponse, *incident.Response, error)
+	// DeleteFunc implements Delete.
+	DeleteFunc func(ctx context.Context, id string) (*incident.Response, error)
+}
+
+var _ incident.CustomFieldOptionsAPI = (*CustomFieldOptionsAPI)(nil)
+
+// List calls ListFunc.
+func (m *CustomFieldOptionsAPI) List(ctx context.Context, opts *incident.CustomFieldOptionsListOptions) (*incident.CustomFieldOptionsList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: CustomFieldOptionsAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// Get calls GetFunc.
+func (m *CustomFieldOptionsAPI) Get(ctx context.Context, id string) (*incident.CustomFieldOptionResponse, *incident.Response, error) {
+	if m.GetFunc == nil {
+		panic("incidentmock: CustomFieldOptionsAPI.Get called, but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// Create calls CreateFunc.
+func (m *CustomFieldOptionsAPI) Create(ctx context.Context, opts *incident.CreateCustomFieldOptionRequest) (*incident.CustomFieldOptionResponse, *incident.Response, error) {
+	if m.CreateFunc == nil {
+		panic("incidentmock: CustomFieldOptionsAPI.Create called, but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, opts)
+}
+
+// Update calls UpdateFunc.
+func (m *CustomFieldOptionsAPI) Update(ctx context.Context, id string, opts *incident.UpdateCustomFieldOptionRequest) (*incident.CustomFieldOptionResponse, *incident.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("incidentmock: CustomFieldOptionsAPI.Update called, but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, id, opts)
+}
+
+// Delete calls DeleteFunc.
+func (m *CustomFieldOptionsAPI) Delete(ctx context.Context, id string) (*incident.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("incidentmock: CustomFieldOptionsAPI.Delete called, but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// CustomFieldsAPI is a mock implementation of incident.CustomFieldsAPI.
+// Calling a method whose func field is not set panics.
+type CustomFieldsAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context) (*incident.CustomFieldsList, *incident.Response, error)
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id string) (*incident.CustomFieldResponse, *incident.Response, error)
+	// CreateFunc implements Create.
+	CreateFunc func(ctx context.Context, opts *incident.CreateCustomFieldRequest) (*incident.CustomFieldResponse, *incident.Response, error)
+	// UpdateFunc implements Update.
+	UpdateFunc func(ctx context.Context, id string, opts *incident.UpdateCustomFieldRequest) (*incident.CustomFieldResponse, *incident.Response, error)
+	// DeleteFunc implements Delete.
+	DeleteFunc func(ctx context.Context, id string) (*incident.Response, error)
+}
+
+var _ incident.CustomFieldsAPI = (*CustomFieldsAPI)(nil)
+
+// List calls ListFunc.
+func (m *CustomFieldsAPI) List(ctx context.Context) (*incident.CustomFieldsList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: CustomFieldsAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx)
+}
+
+// Get calls GetFunc.
+func (m *CustomFieldsAPI) Get(ctx context.Context, id string) (*incident.CustomFieldResponse, *incident.Response, error) {
+	if m.GetFunc == nil {
+		panic("incidentmock: CustomFieldsAPI.Get called, but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// Create calls CreateFunc.
+func (m *CustomFieldsAPI) Create(ctx context.Context, opts *incident.CreateCustomFieldRequest) (*incident.CustomFieldResponse, *incident.Response, error) {
+	if m.CreateFunc == nil {
+		panic("incidentmock: CustomFieldsAPI.Create called, but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, opts)
+}
+
+// Update calls UpdateFunc.
+func (m *CustomFieldsAPI) Update(ctx context.Context, id string, opts *incident.UpdateCustomFieldRequest) (*incident.CustomFieldResponse, *incident.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("incidentmock: CustomFieldsAPI.Update called, but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, id, opts)
+}
+
+// Delete calls DeleteFunc.
+func (m *CustomFieldsAPI) Delete(ctx context.Context, id string) (*incident.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("incidentmock: CustomFieldsAPI.Delete called, but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// FollowUpsAPI is a mock implementation of incident.FollowUpsAPI.
+// Calling a method whose func field is not set panics.
+type FollowUpsAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context, opts *incident.FollowUpsListOptions) (*incident.FollowUpsList, *incident.Response, error)
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id string) (*incident.FollowUpResponse, *incident.Response, error)
+}
+
+var _ incident.FollowUpsAPI = (*FollowUpsAPI)(nil)
+
+// List calls ListFunc.
+func (m *FollowUpsAPI) List(ctx context.Context, opts *incident.FollowUpsListOptions) (*incident.FollowUpsList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: FollowUpsAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// Get calls GetFunc.
+func (m *FollowUpsAPI) Get(ctx context.Context, id string) (*incident.FollowUpResponse, *incident.Response, error) {
+	if m.GetFunc == nil {
+		panic("incidentmock: FollowUpsAPI.Get called, but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// IncidentAttachmentsAPI is a mock implementation of incident.IncidentAttachmentsAPI.
+// Calling a method whose func field is not set panics.
+type IncidentAttachmentsAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context, opts *incident.IncidentAttachmentsListOptions) (*incident.IncidentAttachmentsList, *incident.Response, error)
+	// CreateFunc implements Create.
+	CreateFunc func(ctx context.Context, opts *incident.CreateIncidentAttachmentRequest) (*incident.IncidentAttachmentResponse, *incident.Response, error)
+	// DeleteFunc implements Delete.
+	DeleteFunc func(ctx context.Context, id string) (*incident.Response, error)
+}
+
+var _ incident.IncidentAttachmentsAPI = (*IncidentAttachmentsAPI)(nil)
+
+// List calls ListFunc.
+func (m *IncidentAttachmentsAPI) List(ctx context.Context, opts *incident.IncidentAttachmentsListOptions) (*incident.IncidentAttachmentsList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: IncidentAttachmentsAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// Create calls CreateFunc.
+func (m *IncidentAttachmentsAPI) Create(ctx context.Context, opts *incident.CreateIncidentAttachmentRequest) (*incident.IncidentAttachmentResponse, *incident.Response, error) {
+	if m.CreateFunc == nil {
+		panic("incidentmock: IncidentAttachmentsAPI.Create called, but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, opts)
+}
+
+// Delete calls DeleteFunc.
+func (m *IncidentAttachmentsAPI) Delete(ctx context.Context, id string) (*incident.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("incidentmock: IncidentAttachmentsAPI.Delete called, but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// IncidentRolesAPI is a mock implementation of incident.IncidentRolesAPI.
+// Calling a method whose func field is not set panics.
+type IncidentRolesAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context) (*incident.IncidentRolesList, *incident.Response, error)
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id string) (*incident.IncidentRoleResponse, *incident.Response, error)
+	// CreateFunc implements Create.
+	CreateFunc func(ctx context.Context, opts *incident.CreateIncidentRoleRequest) (*incident.IncidentRoleResponse, *incident.Response, error)
+	// UpdateFunc implements Update.
+	UpdateFunc func(ctx context.Context, id string, opts *incident.UpdateIncidentRoleRequest) (*incident.IncidentRoleResponse, *incident.Response, error)
+	// DeleteFunc implements Delete.
+	DeleteFunc func(ctx context.Context, id string) (*incident.Response, error)
+}
+
+var _ incident.IncidentRolesAPI = (*IncidentRolesAPI)(nil)
+
+// List calls ListFunc.
+func (m *IncidentRolesAPI) List(ctx context.Context) (*incident.IncidentRolesList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: IncidentRolesAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx)
+}
+
+// Get calls GetFunc.
+func (m *IncidentRolesAPI) Get(ctx context.Context, id string) (*incident.IncidentRoleResponse, *incident.Response, error) {
+	if m.GetFunc == nil {
+		panic("incidentmock: IncidentRolesAPI.Get called, but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// Create calls CreateFunc.
+func (m *IncidentRolesAPI) Create(ctx context.Context, opts *incident.CreateIncidentRoleRequest) (*incident.IncidentRoleResponse, *incident.Response, error) {
+	if m.CreateFunc == nil {
+		panic("incidentmock: IncidentRolesAPI.Create called, but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, opts)
+}
+
+// Update calls UpdateFunc.
+func (m *IncidentRolesAPI) Update(ctx context.Context, id string, opts *incident.UpdateIncidentRoleRequest) (*incident.IncidentRoleResponse, *incident.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("incidentmock: IncidentRolesAPI.Update called, but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, id, opts)
+}
+
+// Delete calls DeleteFunc.
+func (m *IncidentRolesAPI) Delete(ctx context.Context, id string) (*incident.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("incidentmock: IncidentRolesAPI.Delete called, but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// IncidentStatusesAPI is a mock implementation of incident.IncidentStatusesAPI.
+// Calling a method whose func field is not set panics.
+type IncidentStatusesAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context) (*incident.IncidentStatusesList, *incident.Response, error)
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id string) (*incident.IncidentStatusResponse, *incident.Response, error)
+	// CreateFunc implements Create.
+	CreateFunc func(ctx context.Context, opts *incident.CreateIncidentStatusRequest) (*incident.IncidentStatusResponse, *incident.Response, error)
+	// UpdateFunc implements Update.
+	UpdateFunc func(ctx context.Context, id string, opts *incident.UpdateIncidentStatusRequest) (*incident.IncidentStatusResponse, *incident.Response, error)
+	// DeleteFunc implements Delete.
+	DeleteFunc func(ctx context.Context, id string) (*incident.Response, error)
+}
+
+var _ incident.IncidentStatusesAPI = (*IncidentStatusesAPI)(nil)
+
+// List calls ListFunc.
+func (m *IncidentStatusesAPI) List(ctx context.Context) (*incident.IncidentStatusesList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: IncidentStatusesAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx)
+}
+
+// Get calls GetFunc.
+func (m *IncidentStatusesAPI) Get(ctx context.Context, id string) (*incident.IncidentStatusResponse, *incident.Response, error) {
+	if m.GetFunc == nil {
+		panic("incidentmock: IncidentStatusesAPI.Get called, but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// Create calls CreateFunc.
+func (m *IncidentStatusesAPI) Create(ctx context.Context, opts *incident.CreateIncidentStatusRequest) (*incident.IncidentStatusResponse, *incident.Response, error) {
+	if m.CreateFunc == nil {
+		panic("incidentmock: IncidentStatusesAPI.Create called, but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, opts)
+}
+
+// Update calls UpdateFunc.
+func (m *IncidentStatusesAPI) Update(ctx context.Context, id string, opts *incident.UpdateIncidentStatusRequest) (*incident.IncidentStatusResponse, *incident.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("incidentmock: IncidentStatusesAPI.Update called, but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, id, opts)
+}
+
+// Delete calls DeleteFunc.
+func (m *IncidentStatusesAPI) Delete(ctx context.Context, id string) (*incident.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("incidentmock: IncidentStatusesAPI.Delete called, but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// IncidentTypesAPI is a mock implementation of incident.IncidentTypesAPI.
+// Calling a method whose func field is not set panics.
+type IncidentTypesAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context) (*incident.IncidentTypesList, *incident.Response, error)
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id string) (*incident.IncidentTypeResponse, *incident.Response, error)
+}
+
+var _ incident.IncidentTypesAPI = (*IncidentTypesAPI)(nil)
+
+// List calls ListFunc.
+func (m *IncidentTypesAPI) List(ctx context.Context) (*incident.IncidentTypesList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: IncidentTypesAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx)
+}
+
+// Get calls GetFunc.
+func (m *IncidentTypesAPI) Get(ctx context.Context, id string) (*incident.IncidentTypeResponse, *incident.Response, error) {
+	if m.GetFunc == nil {
+		panic("incidentmock: IncidentTypesAPI.Get called, but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// IncidentUpdatesAPI is a mock implementation of incident.IncidentUpdatesAPI.
+// Calling a method whose func field is not set panics.
+type IncidentUpdatesAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context, opts *incident.IncidentUpdatesListOptions) (*incident.IncidentUpdatesList, *incident.Response, error)
+}
+
+var _ incident.IncidentUpdatesAPI = (*IncidentUpdatesAPI)(nil)
+
+// List calls ListFunc.
+func (m *IncidentUpdatesAPI) List(ctx context.Context, opts *incident.IncidentUpdatesListOptions) (*incident.IncidentUpdatesList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: IncidentUpdatesAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// IncidentsAPI is a mock implementation of incident.IncidentsAPI.
+// Calling a method whose func field is not set panics.
+type IncidentsAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context, opts *incident.IncidentsListOptions) (*incident.IncidentsList, *incident.Response, error)
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id string) (*incident.IncidentResponse, *incident.Response, error)
+	// GetV2Func implements GetV2.
+	GetV2Func func(ctx context.Context, id string) (*incident.IncidentResponse, *incident.Response, error)
+	// CreateFunc implements Create.
+	CreateFunc func(ctx context.Context, opts *incident.CreateIncidentRequest) (*incident.IncidentResponse, *incident.Response, error)
+	// EditFunc implements Edit.
+	EditFunc func(ctx context.Context, id string, opts *incident.EditIncidentRequest) (*incident.IncidentResponse, *incident.Response, error)
+}
+
+var _ incident.IncidentsAPI = (*IncidentsAPI)(nil)
+
+// List calls ListFunc.
+func (m *IncidentsAPI) List(ctx context.Context, opts *incident.IncidentsListOptions) (*incident.IncidentsList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: IncidentsAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// Get calls GetFunc.
+func (m *IncidentsAPI) Get(ctx context.Context, id string) (*incident.IncidentResponse, *incident.Response, error) {
+	if m.GetFunc == nil {
+		panic("incidentmock: IncidentsAPI.Get called, but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// GetV2 calls GetV2Func.
+func (m *IncidentsAPI) GetV2(ctx context.Context, id string) (*incident.IncidentResponse, *incident.Response, error) {
+	if m.GetV2Func == nil {
+		panic("incidentmock: IncidentsAPI.GetV2 called, but GetV2Func is not set")
+	}
+	return m.GetV2Func(ctx, id)
+}
+
+// Create calls CreateFunc.
+func (m *IncidentsAPI) Create(ctx context.Context, opts *incident.CreateIncidentRequest) (*incident.IncidentResponse, *incident.Response, error) {
+	if m.CreateFunc == nil {
+		panic("incidentmock: IncidentsAPI.Create called, but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, opts)
+}
+
+// Edit calls EditFunc.
+func (m *IncidentsAPI) Edit(ctx context.Context, id string, opts *incident.EditIncidentRequest) (*incident.IncidentResponse, *incident.Response, error) {
+	if m.EditFunc == nil {
+		panic("incidentmock: IncidentsAPI.Edit called, but EditFunc is not set")
+	}
+	return m.EditFunc(ctx, id, opts)
+}
+
+// SeveritiesAPI is a mock implementation of incident.SeveritiesAPI.
+// Calling a method whose func field is not set panics.
+type SeveritiesAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context) (*incident.SeveritiesList, *incident.Response, error)
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id string) (*incident.SeverityResponse, *incident.Response, error)
+	// CreateFunc implements Create.
+	CreateFunc func(ctx context.Context, opts *incident.CreateSeverityRequest) (*incident.SeverityResponse, *incident.Response, error)
+	// UpdateFunc implements Update.
+	UpdateFunc func(ctx context.Context, id string, opts *incident.UpdateSeverityRequest) (*incident.SeverityResponse, *incident.Response, error)
+	// DeleteFunc implements Delete.
+	DeleteFunc func(ctx context.Context, id string) (*incident.Response, error)
+}
+
+var _ incident.SeveritiesAPI = (*SeveritiesAPI)(nil)
+
+// List calls ListFunc.
+func (m *SeveritiesAPI) List(ctx context.Context) (*incident.SeveritiesList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: SeveritiesAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx)
+}
+
+// Get calls GetFunc.
+func (m *SeveritiesAPI) Get(ctx context.Context, id string) (*incident.SeverityResponse, *incident.Response, error) {
+	if m.GetFunc == nil {
+		panic("incidentmock: SeveritiesAPI.Get called, but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// Create calls CreateFunc.
+func (m *SeveritiesAPI) Create(ctx context.Context, opts *incident.CreateSeverityRequest) (*incident.SeverityResponse, *incident.Response, error) {
+	if m.CreateFunc == nil {
+		panic("incidentmock: SeveritiesAPI.Create called, but CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, opts)
+}
+
+// Update calls UpdateFunc.
+func (m *SeveritiesAPI) Update(ctx context.Context, id string, opts *incident.UpdateSeverityRequest) (*incident.SeverityResponse, *incident.Response, error) {
+	if m.UpdateFunc == nil {
+		panic("incidentmock: SeveritiesAPI.Update called, but UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, id, opts)
+}
+
+// Delete calls DeleteFunc.
+func (m *SeveritiesAPI) Delete(ctx context.Context, id string) (*incident.Response, error) {
+	if m.DeleteFunc == nil {
+		panic("incidentmock: SeveritiesAPI.Delete called, but DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, id)
+}
+
+// UsersAPI is a mock implementation of incident.UsersAPI.
+// Calling a method whose func field is not set panics.
+type UsersAPI struct {
+	// ListFunc implements List.
+	ListFunc func(ctx context.Context, opts *incident.UsersListOptions) (*incident.UsersList, *incident.Response, error)
+	// GetFunc implements Get.
+	GetFunc func(ctx context.Context, id string) (*incident.UserResponse, *incident.Response, error)
+	// GetByEmailFunc implements GetByEmail.
+	GetByEmailFunc func(ctx context.Context, email string) (*incident.UserResponse, *incident.Response, error)
+	// GetBySlackUserIDFunc implements GetBySlackUserID.
+	GetBySlackUserIDFunc func(ctx context.Context, slackUserID string) (*incident.UserResponse, *incident.Response, error)
+}
+
+var _ incident.UsersAPI = (*UsersAPI)(nil)
+
+// List calls ListFunc.
+func (m *UsersAPI) List(ctx context.Context, opts *incident.UsersListOptions) (*incident.UsersList, *incident.Response, error) {
+	if m.ListFunc == nil {
+		panic("incidentmock: UsersAPI.List called, but ListFunc is not set")
+	}
+	return m.ListFunc(ctx, opts)
+}
+
+// Get calls GetFunc.
+func (m *UsersAPI) Get(ctx context.Context, id string) (*incident.UserResponse, *incident.Response, error) {
+	if m.GetFunc == nil {
+		panic("incidentmock: UsersAPI.Get called, but GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// GetByEmail calls GetByEmailFunc.
+func (m *UsersAPI) GetByEmail(ctx context.Context, email string) (*incident.UserResponse, *incident.Response, error) {
+	if m.GetByEmailFunc == nil {
+		panic("incidentmock: UsersAPI.GetByEmail called, but GetByEmailFunc is not set")
+	}
+	return m.GetByEmailFunc(ctx, email)
+}
+
+// GetBySlackUserID calls GetBySlackUserIDFunc.
+func (m *UsersAPI) GetBySlackUserID(ctx context.Context, slackUserID string) (*incident.UserResponse, *incident.Response, error) {
+	if m.GetBySlackUserIDFunc == nil {
+		panic("incidentmock: UsersAPI.GetBySlackUserID called, but GetBySlackUserIDFunc is not set")
+	}
+	return m.GetBySlackUserIDFunc(ctx, slackUserID)
+}
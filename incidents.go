@@ -2,9 +2,14 @@ package incident
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
+// ErrMissingIdempotencyKey is returned by IncidentsService.Create if
+// no idempotency key has been set on the request.
+var ErrMissingIdempotencyKey = errors.New("idempotency key must be set to create an incident")
+
 // IncidentsService handles communication with the incident related
 // methods of the Incident.io API.
 //
@@ -58,4 +63,216 @@ func (s *IncidentsService) Get(ctx context.Context, id string) (*IncidentRespons
 	return v, resp, nil
 }
 
-// TODO Add Create Incident: https://api-docs.incident.io/#operation/Incidents_Create
+// GetV2 returns a single incident from version 2 of the API.
+// Unlike Get, the incident carries its IncidentStatus, which resolves
+// the custom incident statuses configured by the organisation.
+//
+// id represents the unique identifier for the incident
+//
+// API docs: https://api-docs.incident.io/#operation/Incidents%20V2_Show
+func (s *IncidentsService) GetV2(ctx context.Context, id string) (*IncidentResponse, *Response, error) {
+	u := apiV2Path(fmt.Sprintf("incidents/%s", id))
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Create creates a new incident.
+//
+// The idempotency key of opts is mandatory. Requests with the same
+// idempotency key will only ever create a single incident, which makes
+// it safe to retry a failed call.
+//
+// API docs: https://api-docs.incident.io/#operation/Incidents_Create
+func (s *IncidentsService) Create(ctx context.Context, opts *CreateIncidentRequest) (*IncidentResponse, *Response, error) {
+	if opts == nil || opts.IdempotencyKey == "" {
+		return nil, nil, ErrMissingIdempotencyKey
+	}
+
+	u := "incidents"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The idempotency key makes this request safe to retry.
+	req = MarkRetryable(req)
+
+	v := &IncidentResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Edit edits an existing incident.
+//
+// Only the fields set in opts are sent to the API, every other field of the
+// incident is left untouched. See EditIncidentFields for how to clear a field.
+//
+// The API responds with a version 2 incident, so Status and Type of the
+// returned incident are always empty. Use IncidentStatus for the status.
+//
+// id represents the unique identifier for the incident
+//
+// API docs: https://api-docs.incident.io/#operation/Incidents%20V2_Edit
+func (s *IncidentsService) Edit(ctx context.Context, id string, opts *EditIncidentRequest) (*IncidentResponse, *Response, error) {
+	if opts == nil {
+		return nil, nil, errors.New("edit request must be set")
+	}
+
+	u := apiV2Path(fmt.Sprintf("incidents/%s/actions/edit", id))
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &IncidentResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// AssignRoles assigns users to incident roles of an existing incident,
+// or unassigns them if an assignment has no assignee.
+// See AssignIncidentRoles.
+//
+// id represents the unique identifier for the incident
+func (s *IncidentsService) AssignRoles(ctx context.Context, id string, opts *AssignIncidentRolesRequest) (*IncidentResponse, *Response, error) {
+	return AssignIncidentRoles(ctx, s, s.client.IncidentRoles, id, opts)
+}
+
+// AssignIncidentRoles assigns users to incident roles of an existing incident,
+// or unassigns them if an assignment has no assignee.
+// Users can be referenced by ID, email or Slack user ID.
+//
+// Every role is looked up with roles.Get before the incident is edited
+// with incidents.Edit, so unknown role IDs fail without changing the incident.
+//
+// id represents the unique identifier for the incident
+func AssignIncidentRoles(ctx context.Context, incidents IncidentsAPI, roles IncidentRolesAPI, id string, opts *AssignIncidentRolesRequest) (*IncidentResponse, *Response, error) {
+	if opts == nil || len(opts.Assignments) == 0 {
+		return nil, nil, errors.New("at least one role assignment must be set")
+	}
+
+	checked := map[string]bool{}
+	for _, a := range opts.Assignments {
+		if a.IncidentRoleID == "" {
+			return nil, nil, errors.New("incident role ID must be set")
+		}
+		if a.Assignee != nil && *a.Assignee == (UserReference{}) {
+			return nil, nil, fmt.Errorf("assignee of incident role %q must be referenced by ID, email or Slack user ID", a.IncidentRoleID)
+		}
+		if checked[a.IncidentRoleID] {
+			continue
+		}
+
+		_, resp, err := roles.Get(ctx, a.IncidentRoleID)
+		if err != nil {
+			return nil, resp, fmt.Errorf("looking up incident role %q: %w", a.IncidentRoleID, err)
+		}
+		checked[a.IncidentRoleID] = true
+	}
+
+	edit := &EditIncidentRequest{
+		Incident: EditIncidentFields{
+			IncidentRoleAssignments: opts.Assignments,
+		},
+		NotifyIncidentChannel: opts.NotifyIncidentChannel,
+	}
+	return incidents.Edit(ctx, id, edit)
+}
+
+// Iter returns an iterator over all incidents matching opts.
+// The iterator follows the After cursor of the API and fetches one page
+// at a time, so results are streamed instead of being buffered in memory.
+// opts is copied and not modified by the iterator.
+//
+//	it := client.Incidents.Iter(&incident.IncidentsListOptions{PageSize: 25})
+//	for it.Next(ctx) {
+//		fmt.Println(it.Incident().Name)
+//	}
+//	if err := it.Err(); err != nil {
+//		// Handle error
+//	}
+func (s *IncidentsService) Iter(opts *IncidentsListOptions) *IncidentsIterator {
+	return NewIncidentsIterator(s, opts)
+}
+
+// ListAll calls fn for every incident matching opts, following all pages.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func (s *IncidentsService) ListAll(ctx context.Context, opts *IncidentsListOptions, fn func(Incident) error) error {
+	return ListAllIncidents(ctx, s, opts, fn)
+}
+
+// IncidentsIterator iterates over the pages of IncidentsService.List.
+// Create one with IncidentsService.Iter.
+type IncidentsIterator struct {
+	pager *cursorPager[Incident]
+}
+
+// NewIncidentsIterator returns an iterator over all incidents matching opts,
+// that fetches the pages with api.List.
+// This is useful to iterate over incidents of a mock IncidentsAPI.
+// opts is copied and not modified by the iterator.
+func NewIncidentsIterator(api IncidentsAPI, opts *IncidentsListOptions) *IncidentsIterator {
+	o := IncidentsListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	fetch := func(ctx context.Context, after string) ([]Incident, *PaginationMeta, error) {
+		o.After = after
+		list, _, err := api.List(ctx, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+		return list.Incidents, list.PaginationMeta, nil
+	}
+	id := func(i Incident) string { return i.Id }
+
+	return &IncidentsIterator{pager: newCursorPager(o.After, fetch, id)}
+}
+
+// ListAllIncidents calls fn for every incident matching opts, following all pages
+// fetched with api.List.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func ListAllIncidents(ctx context.Context, api IncidentsAPI, opts *IncidentsListOptions, fn func(Incident) error) error {
+	return forEach(ctx, NewIncidentsIterator(api, opts).pager, fn)
+}
+
+// Next advances the iterator to the next incident, fetching the next page
+// if required. It returns false when there are no more incidents or an error
+// occurred. Check Err after Next returned false.
+func (it *IncidentsIterator) Next(ctx context.Context) bool {
+	return it.pager.next(ctx)
+}
+
+// Incident returns the current incident.
+// It is only valid after a call to Next returned true.
+func (it *IncidentsIterator) Incident() Incident {
+	return it.pager.current
+}
+
+// Err returns the first error that occurred during iteration, if any.
+func (it *IncidentsIterator) Err() error {
+	return it.pager.err
+}
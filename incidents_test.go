@@ -0,0 +1,397 @@
+package incident_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/andygrunwald/go-incident"
+	"github.com/andygrunwald/go-incident/incidentmock"
+	"github.com/andygrunwald/go-incident/incidenttest"
+)
+
+func TestIncidentsService_GetV2(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	inc := srv.AddIncident(incident.Incident{Name: "Database is down"})
+	client := srv.Client()
+
+	got, _, err := client.Incidents.GetV2(context.Background(), inc.Id)
+	if err != nil {
+		t.Fatalf("GetV2 returned error: %v", err)
+	}
+	if got.Incident.Id != inc.Id {
+		t.Errorf("GetV2 returned incident %q, want %q", got.Incident.Id, inc.Id)
+	}
+
+	_, _, err = client.Incidents.GetV2(context.Background(), "unknown")
+	var errResp *incident.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Status != http.StatusNotFound {
+		t.Errorf("GetV2 with unknown ID returned error %v, want 404 error response", err)
+	}
+}
+
+func TestIncidentsService_Create_Idempotency(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	req := &incident.CreateIncidentRequest{
+		IdempotencyKey: "alert-1",
+		Name:           "Database is down",
+		Visibility:     incident.IncidentVisibilityPublic,
+	}
+	first, _, err := client.Incidents.Create(ctx, req)
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	second, _, err := client.Incidents.Create(ctx, req)
+	if err != nil {
+		t.Fatalf("Create returned error on repeated request: %v", err)
+	}
+
+	if first.Incident.Id != second.Incident.Id {
+		t.Errorf("repeated Create returned incident %q, want %q", second.Incident.Id, first.Incident.Id)
+	}
+	if got := len(srv.Incidents()); got != 1 {
+		t.Errorf("server has %d incidents, want 1", got)
+	}
+
+	req.IdempotencyKey = "alert-2"
+	if _, _, err := client.Incidents.Create(ctx, req); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if got := len(srv.Incidents()); got != 2 {
+		t.Errorf("server has %d incidents, want 2", got)
+	}
+}
+
+func TestIncidentsService_Create_Retry(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+
+	var headers []http.Header
+	client := srv.Client(
+		incident.WithRetry(&incident.RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}),
+		incident.WithMiddleware(recordHeaders(&headers)),
+	)
+	srv.InjectError(incidenttest.InjectedError{
+		Method:     http.MethodPost,
+		Path:       "/v1/incidents",
+		StatusCode: http.StatusServiceUnavailable,
+	})
+
+	_, _, err := client.Incidents.Create(context.Background(), &incident.CreateIncidentRequest{
+		IdempotencyKey: "alert-1",
+		Name:           "Database is down",
+	})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	if got := len(headers); got != 2 {
+		t.Errorf("Create sent %d requests, want 2", got)
+	}
+	for _, h := range headers {
+		if v := h.Get("Idempotency-Key"); v != "" {
+			t.Errorf("Create sent Idempotency-Key header %q, want none", v)
+		}
+	}
+	if got := len(srv.Incidents()); got != 1 {
+		t.Errorf("server has %d incidents, want 1", got)
+	}
+}
+
+func TestIncidentsService_Create_MissingIdempotencyKey(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+
+	_, _, err := srv.Client().Incidents.Create(context.Background(), &incident.CreateIncidentRequest{Name: "No key"})
+	if !errors.Is(err, incident.ErrMissingIdempotencyKey) {
+		t.Errorf("Create returned error %v, want %v", err, incident.ErrMissingIdempotencyKey)
+	}
+	if got := len(srv.Incidents()); got != 0 {
+		t.Errorf("server has %d incidents, want 0", got)
+	}
+}
+
+func TestIncidentsService_Iter(t *testing.T) {
+	tests := []struct {
+		name      string
+		incidents int
+		pageSize  int
+		wantPages int
+	}{
+		{name: "empty", incidents: 0, pageSize: 2, wantPages: 1},
+		{name: "partial last page", incidents: 5, pageSize: 2, wantPages: 3},
+		{name: "full last page", incidents: 4, pageSize: 2, wantPages: 3},
+		{name: "single page", incidents: 3, pageSize: 10, wantPages: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := incidenttest.NewServer()
+			defer srv.Close()
+
+			var want []string
+			for i := 0; i < tt.incidents; i++ {
+				want = append(want, srv.AddIncident(incident.Incident{Name: "Incident"}).Id)
+			}
+
+			var pages int
+			client := srv.Client(incident.WithMiddleware(countRequests(&pages)))
+
+			var got []string
+			it := client.Incidents.Iter(&incident.IncidentsListOptions{PageSize: tt.pageSize})
+			for it.Next(context.Background()) {
+				got = append(got, it.Incident().Id)
+			}
+			if err := it.Err(); err != nil {
+				t.Fatalf("Err returned %v", err)
+			}
+
+			if !equalStrings(got, want) {
+				t.Errorf("Iter returned %v, want %v", got, want)
+			}
+			if pages != tt.wantPages {
+				t.Errorf("Iter fetched %d pages, want %d", pages, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestIncidentsService_Iter_ContextCanceled(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	srv.AddIncident(incident.Incident{Name: "Incident"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	it := srv.Client().Incidents.Iter(nil)
+	if it.Next(ctx) {
+		t.Fatal("Next returned true for canceled context")
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Errorf("Err returned %v, want %v", it.Err(), context.Canceled)
+	}
+}
+
+func TestIncidentsService_Edit(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	sev := srv.AddSeverity(incident.Severity{Name: "Major"})
+	inc := srv.AddIncident(incident.Incident{Name: "Database is down", Summary: "Old summary"})
+
+	got, _, err := srv.Client().Incidents.Edit(context.Background(), inc.Id, &incident.EditIncidentRequest{
+		Incident: incident.EditIncidentFields{
+			Summary:    incident.String(""),
+			SeverityID: incident.String(sev.Id),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Edit returned error: %v", err)
+	}
+
+	if got.Incident.Name != inc.Name {
+		t.Errorf("Edit changed name to %q, want it untouched", got.Incident.Name)
+	}
+	if got.Incident.Summary != "" {
+		t.Errorf("Edit left summary %q, want it cleared", got.Incident.Summary)
+	}
+	if got.Incident.Severity.Id != sev.Id {
+		t.Errorf("Edit set severity %q, want %q", got.Incident.Severity.Id, sev.Id)
+	}
+}
+
+func TestIncidentsService_Edit_NilRequest(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	inc := srv.AddIncident(incident.Incident{Name: "Database is down"})
+
+	if _, _, err := srv.Client().Incidents.Edit(context.Background(), inc.Id, nil); err == nil {
+		t.Errorf("Edit returned no error for a nil request")
+	}
+}
+
+func TestIncidentsService_AssignRoles(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	role := srv.AddIncidentRole(incident.IncidentRole{Name: "Communications lead"})
+	inc := srv.AddIncident(incident.Incident{Name: "Database is down"})
+
+	got, _, err := client.Incidents.AssignRoles(ctx, inc.Id, &incident.AssignIncidentRolesRequest{
+		Assignments: []incident.IncidentRoleAssignmentPayload{
+			{IncidentRoleID: role.Id, Assignee: &incident.UserReference{Email: "jane@example.com"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("AssignRoles returned error: %v", err)
+	}
+	if n := len(got.Incident.IncidentRoleAssignments); n != 1 {
+		t.Fatalf("incident has %d role assignments, want 1", n)
+	}
+	if a := got.Incident.IncidentRoleAssignments[0]; a.Role.Id != role.Id || a.Assignee.Email != "jane@example.com" {
+		t.Errorf("role assignment is %+v, want %s assigned to jane@example.com", a, role.Id)
+	}
+
+	got, _, err = client.Incidents.AssignRoles(ctx, inc.Id, &incident.AssignIncidentRolesRequest{
+		Assignments: []incident.IncidentRoleAssignmentPayload{{IncidentRoleID: role.Id}},
+	})
+	if err != nil {
+		t.Fatalf("AssignRoles returned error when unassigning: %v", err)
+	}
+	if n := len(got.Incident.IncidentRoleAssignments); n != 0 {
+		t.Errorf("incident has %d role assignments after unassigning, want 0", n)
+	}
+
+	_, _, err = client.Incidents.AssignRoles(ctx, inc.Id, &incident.AssignIncidentRolesRequest{
+		Assignments: []incident.IncidentRoleAssignmentPayload{{IncidentRoleID: "unknown"}},
+	})
+	var errResp *incident.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Status != http.StatusNotFound {
+		t.Errorf("AssignRoles with unknown role returned error %v, want 404 error response", err)
+	}
+}
+
+func TestAssignIncidentRoles_Mock(t *testing.T) {
+	errNotFound := errors.New("not found")
+	roles := &incidentmock.IncidentRolesAPI{
+		GetFunc: func(ctx context.Context, id string) (*incident.IncidentRoleResponse, *incident.Response, error) {
+			if id != "role_1" {
+				return nil, nil, errNotFound
+			}
+			return &incident.IncidentRoleResponse{IncidentRole: incident.IncidentRole{Id: id}}, nil, nil
+		},
+	}
+	var edited []*incident.EditIncidentRequest
+	incidents := &incidentmock.IncidentsAPI{
+		EditFunc: func(ctx context.Context, id string, opts *incident.EditIncidentRequest) (*incident.IncidentResponse, *incident.Response, error) {
+			edited = append(edited, opts)
+			return &incident.IncidentResponse{Incident: incident.Incident{Id: id}}, nil, nil
+		},
+	}
+	ctx := context.Background()
+
+	_, _, err := incident.AssignIncidentRoles(ctx, incidents, roles, "inc_1", &incident.AssignIncidentRolesRequest{
+		Assignments: []incident.IncidentRoleAssignmentPayload{{IncidentRoleID: "role_1"}},
+	})
+	if err != nil {
+		t.Fatalf("AssignIncidentRoles returned error: %v", err)
+	}
+	if len(edited) != 1 || len(edited[0].Incident.IncidentRoleAssignments) != 1 {
+		t.Fatalf("AssignIncidentRoles sent edits %+v, want one with the role assignment", edited)
+	}
+
+	_, _, err = incident.AssignIncidentRoles(ctx, incidents, roles, "inc_1", &incident.AssignIncidentRolesRequest{
+		Assignments: []incident.IncidentRoleAssignmentPayload{{IncidentRoleID: "unknown"}},
+	})
+	if !errors.Is(err, errNotFound) {
+		t.Errorf("AssignIncidentRoles with unknown role returned error %v, want %v", err, errNotFound)
+	}
+	if len(edited) != 1 {
+		t.Errorf("AssignIncidentRoles edited the incident despite an unknown role")
+	}
+}
+
+func TestIncidentsService_InjectedError(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	inc := srv.AddIncident(incident.Incident{Name: "Database is down"})
+	client := srv.Client()
+	ctx := context.Background()
+
+	srv.InjectError(incidenttest.InjectedError{
+		Method:     http.MethodGet,
+		Path:       "/v1/incidents/" + inc.Id,
+		StatusCode: http.StatusInternalServerError,
+		Type:       "internal_error",
+		Errors:     []incident.Error{{Code: "internal", Message: "boom"}},
+	})
+
+	_, resp, err := client.Incidents.Get(ctx, inc.Id)
+	var errResp *incident.ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Get returned error %v, want *incident.ErrorResponse", err)
+	}
+	if errResp.Status != http.StatusInternalServerError || errResp.Type != "internal_error" {
+		t.Errorf("error response has status %d and type %q, want 500 and internal_error", errResp.Status, errResp.Type)
+	}
+	if len(errResp.Errors) != 1 || errResp.Errors[0].Message != "boom" {
+		t.Errorf("error response has errors %+v, want the injected one", errResp.Errors)
+	}
+	if resp == nil || resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Get returned response %v, want status 500", resp)
+	}
+
+	// The error is consumed by the first matching request.
+	if _, _, err := client.Incidents.Get(ctx, inc.Id); err != nil {
+		t.Errorf("second Get returned error %v, want nil", err)
+	}
+}
+
+func TestIncidentsService_InjectedError_Times(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	srv.InjectError(incidenttest.InjectedError{
+		Path:       "/v1/incidents",
+		StatusCode: http.StatusServiceUnavailable,
+		Times:      2,
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Incidents.List(ctx, nil); err == nil {
+			t.Fatalf("List %d returned no error, want injected error", i+1)
+		}
+	}
+	if _, _, err := client.Incidents.List(ctx, nil); err != nil {
+		t.Errorf("List returned error %v after injected errors were consumed", err)
+	}
+}
+
+// countRequests returns a middleware that counts the requests sent to the API.
+func countRequests(n *int) func(http.RoundTripper) http.RoundTripper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			*n++
+			return next.RoundTrip(r)
+		})
+	}
+}
+
+// recordHeaders returns a middleware that records the headers of the requests sent to the API.
+func recordHeaders(headers *[]http.Header) func(http.RoundTripper) http.RoundTripper {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			*headers = append(*headers, r.Header.Clone())
+			return next.RoundTrip(r)
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,830 @@
+// Package incidenttest provides an in-memory fake of the Incident.io API
+// for testing code that uses go-incident, without talking to the real API.
+//
+// The fake keeps its state in memory and implements the v1 endpoints of
+// incidents, actions, severities, incident roles and custom fields,
+// including pagination. Of the v2 API, only getting and editing incidents
+// is implemented, other v2 endpoints respond with 404 Not Found.
+// Errors can be injected to test failure handling.
+//
+//	srv := incidenttest.NewServer()
+//	defer srv.Close()
+//
+//	srv.AddIncident(incident.Incident{Name: "Database is down"})
+//	client := srv.Client()
+//	incidents, _, err := client.Incidents.List(ctx, nil)
+package incidenttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/andygrunwald/go-incident"
+)
+
+// defaultPageSize is the page size used by paginated endpoints
+// if the request does not specify one.
+const defaultPageSize = 25
+
+// Server is a stateful fake of the Incident.io API.
+// All methods are safe for concurrent use.
+type Server struct {
+	srv *httptest.Server
+
+	mu            sync.Mutex
+	nextID        int
+	incidents     []incident.Incident
+	actions       []incident.Action
+	severities    []incident.Severity
+	incidentRoles []incident.IncidentRole
+	customFields  []incident.CustomField
+
+	// Incident IDs by idempotency key of the create request
+	idempotencyKeys map[string]string
+
+	injectedErrors []*InjectedError
+}
+
+// InjectedError describes an error response the Server returns
+// instead of handling a matching request.
+type InjectedError struct {
+	// HTTP method to match, e.g. "GET". Empty matches every method.
+	Method string
+
+	// URL path to match, e.g. "/v1/incidents". Empty matches every path.
+	Path string
+
+	// HTTP status code of the error response
+	StatusCode int
+
+	// Type of the error, as reported in incident.ErrorResponse.Type
+	Type string
+
+	// Individual errors, as reported in incident.ErrorResponse.Errors
+	Errors []incident.Error
+
+	// Additional headers of the error response, e.g. rate limit headers
+	Header http.Header
+
+	// Number of requests that fail with this error.
+	// Zero fails exactly one request, a negative value fails all requests.
+	Times int
+}
+
+// errorBody is the wire format of an API error.
+type errorBody struct {
+	Type      string           `json:"type"`
+	Status    int              `json:"status"`
+	RequestID string           `json:"request_id"`
+	Errors    []incident.Error `json:"errors"`
+}
+
+// NewServer starts and returns a new Server without any data.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		idempotencyKeys: map[string]string{},
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// URL returns the base URL of the server, e.g. "http://127.0.0.1:1234".
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// BaseURL returns the URL to use as incident.Client.BaseURL.
+// Like the real API, it includes the API version and a trailing slash.
+func (s *Server) BaseURL() *url.URL {
+	u, _ := url.Parse(s.srv.URL + "/v1/")
+	return u
+}
+
+// Client returns a new incident.Client that talks to the server.
+// opts are applied after the options that point the client to the server.
+func (s *Server) Client(opts ...incident.Option) *incident.Client {
+	opts = append([]incident.Option{
+		incident.WithBaseURL(s.BaseURL().String()),
+		incident.WithHTTPClient(s.srv.Client()),
+	}, opts...)
+
+	c, err := incident.NewClientWithOptions("incidenttest-api-key", opts...)
+	if err != nil {
+		panic(fmt.Sprintf("incidenttest: creating client: %v", err))
+	}
+	return c
+}
+
+// InjectError makes the server respond with e to matching requests.
+// Injected errors are matched in the order they were added.
+func (s *Server) InjectError(e InjectedError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.injectedErrors = append(s.injectedErrors, &e)
+}
+
+// AddIncident stores i and returns the stored incident.
+// An ID, reference and timestamps are assigned if not set.
+func (s *Server) AddIncident(i incident.Incident) incident.Incident {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addIncident(i)
+}
+
+// addIncident implements AddIncident. s.mu must be held.
+func (s *Server) addIncident(i incident.Incident) incident.Incident {
+	if i.Id == "" {
+		i.Id = s.newID("incident")
+	}
+	if i.Reference == "" {
+		i.Reference = fmt.Sprintf("INC-%d", len(s.incidents)+1)
+	}
+	if i.Status == "" {
+		i.Status = incident.IncidentStatusTriage
+	}
+	if i.Type == "" {
+		i.Type = incident.IncidentTypeReal
+	}
+	if i.Visibility == "" {
+		i.Visibility = incident.IncidentVisibilityPublic
+	}
+	now := time.Now().UTC()
+	if i.CreatedAt.IsZero() {
+		i.CreatedAt = now
+	}
+	if i.UpdatedAt.IsZero() {
+		i.UpdatedAt = now
+	}
+	s.incidents = append(s.incidents, i)
+	return i
+}
+
+// AddAction stores a and returns the stored action.
+// An ID and timestamps are assigned if not set.
+func (s *Server) AddAction(a incident.Action) incident.Action {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if a.Id == "" {
+		a.Id = s.newID("action")
+	}
+	if a.Status == "" {
+		a.Status = incident.ActionStatusOutstanding
+	}
+	now := time.Now().UTC()
+	if a.CreatedAt.IsZero() {
+		a.CreatedAt = now
+	}
+	if a.UpdatedAt.IsZero() {
+		a.UpdatedAt = now
+	}
+	s.actions = append(s.actions, a)
+	return a
+}
+
+// AddSeverity stores sev and returns the stored severity.
+// An ID and timestamps are assigned if not set.
+func (s *Server) AddSeverity(sev incident.Severity) incident.Severity {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sev.Id == "" {
+		sev.Id = s.newID("severity")
+	}
+	now := time.Now().UTC()
+	if sev.CreatedAt.IsZero() {
+		sev.CreatedAt = now
+	}
+	if sev.UpdatedAt.IsZero() {
+		sev.UpdatedAt = now
+	}
+	s.severities = append(s.severities, sev)
+	return sev
+}
+
+// AddIncidentRole stores r and returns the stored incident role.
+// An ID and timestamps are assigned if not set.
+func (s *Server) AddIncidentRole(r incident.IncidentRole) incident.IncidentRole {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Id == "" {
+		r.Id = s.newID("incident-role")
+	}
+	if r.RoleType == "" {
+		r.RoleType = incident.IncidentRoleCustom
+	}
+	now := time.Now().UTC()
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = now
+	}
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = now
+	}
+	s.incidentRoles = append(s.incidentRoles, r)
+	return r
+}
+
+// AddCustomField stores f and returns the stored custom field.
+// IDs and timestamps are assigned if not set, also for the options of f.
+func (s *Server) AddCustomField(f incident.CustomField) incident.CustomField {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if f.Id == "" {
+		f.Id = s.newID("custom-field")
+	}
+	for i := range f.Options {
+		if f.Options[i].Id == "" {
+			f.Options[i].Id = s.newID("custom-field-option")
+		}
+		f.Options[i].CustomFieldId = f.Id
+	}
+	now := time.Now().UTC()
+	if f.CreatedAt.IsZero() {
+		f.CreatedAt = now
+	}
+	if f.UpdatedAt.IsZero() {
+		f.UpdatedAt = now
+	}
+	s.customFields = append(s.customFields, f)
+	return f
+}
+
+// Incidents returns a copy of all stored incidents.
+func (s *Server) Incidents() []incident.Incident {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]incident.Incident(nil), s.incidents...)
+}
+
+// Actions returns a copy of all stored actions.
+func (s *Server) Actions() []incident.Action {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]incident.Action(nil), s.actions...)
+}
+
+// newID returns a new unique identifier. s.mu must be held.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s-%d", prefix, s.nextID)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e := s.matchInjectedError(r); e != nil {
+		for k, v := range e.Header {
+			w.Header()[k] = v
+		}
+		writeError(w, e.StatusCode, e.Type, e.Errors...)
+		return
+	}
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "authentication_error")
+		return
+	}
+
+	if path, ok := cutPrefix(r.URL.Path, "/v2/"); ok {
+		s.serveV2(w, r, path)
+		return
+	}
+
+	// Split "/v1/incidents/ID" into "incidents" and "ID"
+	path, ok := cutPrefix(r.URL.Path, "/v1/")
+	if !ok {
+		writeNotFound(w)
+		return
+	}
+	resource, id, _ := strings.Cut(path, "/")
+
+	switch {
+	case resource == "incidents" && id == "" && r.Method == http.MethodGet:
+		s.listIncidents(w, r)
+	case resource == "incidents" && id == "" && r.Method == http.MethodPost:
+		s.createIncident(w, r)
+	case resource == "incidents" && r.Method == http.MethodGet:
+		for _, i := range s.incidents {
+			if i.Id == id {
+				writeJSON(w, http.StatusOK, incident.IncidentResponse{Incident: i})
+				return
+			}
+		}
+		writeNotFound(w)
+	case resource == "actions" && id == "" && r.Method == http.MethodGet:
+		s.listActions(w, r)
+	case resource == "actions" && r.Method == http.MethodGet:
+		for _, a := range s.actions {
+			if a.Id == id {
+				writeJSON(w, http.StatusOK, incident.ActionResponse{Action: a})
+				return
+			}
+		}
+		writeNotFound(w)
+	case resource == "severities" && id == "" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, incident.SeveritiesList{Severities: append([]incident.Severity{}, s.severities...)})
+	case resource == "severities" && r.Method == http.MethodGet:
+		for _, sev := range s.severities {
+			if sev.Id == id {
+				writeJSON(w, http.StatusOK, incident.SeverityResponse{Severity: sev})
+				return
+			}
+		}
+		writeNotFound(w)
+	case resource == "incident_roles" && id == "" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, incident.IncidentRolesList{IncidentRoles: append([]incident.IncidentRole{}, s.incidentRoles...)})
+	case resource == "incident_roles" && r.Method == http.MethodGet:
+		for _, role := range s.incidentRoles {
+			if role.Id == id {
+				writeJSON(w, http.StatusOK, incident.IncidentRoleResponse{IncidentRole: role})
+				return
+			}
+		}
+		writeNotFound(w)
+	case resource == "incident_roles" && id != "" && r.Method == http.MethodDelete:
+		for n, role := range s.incidentRoles {
+			if role.Id == id {
+				s.incidentRoles = append(s.incidentRoles[:n:n], s.incidentRoles[n+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		writeNotFound(w)
+	case resource == "custom_fields" && id == "" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, incident.CustomFieldsList{CustomFields: append([]incident.CustomField{}, s.customFields...)})
+	case resource == "custom_fields" && r.Method == http.MethodGet:
+		for _, f := range s.customFields {
+			if f.Id == id {
+				writeJSON(w, http.StatusOK, incident.CustomFieldResponse{CustomField: f})
+				return
+			}
+		}
+		writeNotFound(w)
+	default:
+		writeNotFound(w)
+	}
+}
+
+// serveV2 handles the implemented endpoints of the v2 API.
+// path is the request path without the "/v2/" prefix. s.mu must be held.
+func (s *Server) serveV2(w http.ResponseWriter, r *http.Request, path string) {
+	parts := strings.Split(path, "/")
+
+	// Match "incidents/ID"
+	if len(parts) == 2 && parts[0] == "incidents" && parts[1] != "" && r.Method == http.MethodGet {
+		for _, i := range s.incidents {
+			if i.Id == parts[1] {
+				writeJSON(w, http.StatusOK, incident.IncidentResponse{Incident: i})
+				return
+			}
+		}
+		writeNotFound(w)
+		return
+	}
+
+	// Match "incidents/ID/actions/edit"
+	if len(parts) == 4 && parts[0] == "incidents" && parts[2] == "actions" && parts[3] == "edit" && r.Method == http.MethodPost {
+		s.editIncident(w, r, parts[1])
+		return
+	}
+	writeNotFound(w)
+}
+
+// matchInjectedError returns the first injected error matching r and
+// consumes it. s.mu must be held.
+func (s *Server) matchInjectedError(r *http.Request) *InjectedError {
+	for i, e := range s.injectedErrors {
+		if e.Method != "" && e.Method != r.Method {
+			continue
+		}
+		if e.Path != "" && e.Path != r.URL.Path {
+			continue
+		}
+
+		switch {
+		case e.Times < 0:
+		case e.Times <= 1:
+			s.injectedErrors = append(s.injectedErrors[:i:i], s.injectedErrors[i+1:]...)
+		default:
+			e.Times--
+		}
+		return e
+	}
+	return nil
+}
+
+func (s *Server) listIncidents(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	statuses := q["status"]
+
+	var matching []incident.Incident
+	for _, i := range s.incidents {
+		if len(statuses) > 0 && !contains(statuses, i.Status) {
+			continue
+		}
+		matching = append(matching, i)
+	}
+
+	page, meta, err := paginate(len(matching), q, func(n int) string { return matching[n].Id })
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", incident.Error{Code: "invalid_value", Message: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, incident.IncidentsList{
+		Incidents:      append([]incident.Incident{}, matching[page.start:page.end]...),
+		PaginationMeta: meta,
+	})
+}
+
+func (s *Server) createIncident(w http.ResponseWriter, r *http.Request) {
+	var req incident.CreateIncidentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", incident.Error{Code: "invalid_body", Message: err.Error()})
+		return
+	}
+	if req.IdempotencyKey == "" {
+		writeError(w, http.StatusUnprocessableEntity, "validation_error", incident.Error{
+			Code:    "is_required",
+			Message: "idempotency_key is required",
+			Source:  incident.ErrorSource{Field: "idempotency_key"},
+		})
+		return
+	}
+
+	// A repeated request returns the incident created by the first one.
+	if id, ok := s.idempotencyKeys[req.IdempotencyKey]; ok {
+		for _, i := range s.incidents {
+			if i.Id == id {
+				writeJSON(w, http.StatusCreated, incident.IncidentResponse{Incident: i})
+				return
+			}
+		}
+	}
+
+	i := incident.Incident{
+		Name:       req.Name,
+		Summary:    req.Summary,
+		Type:       req.Mode,
+		Visibility: req.Visibility,
+	}
+	if req.SeverityID != "" {
+		sev, ok := s.severity(req.SeverityID)
+		if !ok {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", incident.Error{
+				Code:    "invalid_value",
+				Message: "severity not found",
+				Source:  incident.ErrorSource{Field: "severity_id"},
+			})
+			return
+		}
+		i.Severity = sev
+	}
+	for _, e := range req.CustomFieldEntries {
+		entry, ok := s.customFieldEntry(e)
+		if !ok {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", incident.Error{
+				Code:    "invalid_value",
+				Message: "custom field or option not found",
+				Source:  incident.ErrorSource{Field: "custom_field_entries"},
+			})
+			return
+		}
+		i.CustomFieldEntries = append(i.CustomFieldEntries, entry)
+	}
+	for _, a := range req.IncidentRoleAssignments {
+		role, ok := s.incidentRole(a.IncidentRoleID)
+		if !ok {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", incident.Error{
+				Code:    "invalid_value",
+				Message: "incident role not found",
+				Source:  incident.ErrorSource{Field: "incident_role_assignments"},
+			})
+			return
+		}
+		assignment := incident.IncidentRoleAssignment{Role: role}
+		if a.Assignee != nil {
+			assignment.Assignee = &incident.User{Id: a.Assignee.ID, Email: a.Assignee.Email, SlackUserID: a.Assignee.SlackUserID}
+		}
+		i.IncidentRoleAssignments = append(i.IncidentRoleAssignments, assignment)
+	}
+
+	i = s.addIncident(i)
+	s.idempotencyKeys[req.IdempotencyKey] = i.Id
+
+	writeJSON(w, http.StatusCreated, incident.IncidentResponse{Incident: i})
+}
+
+func (s *Server) editIncident(w http.ResponseWriter, r *http.Request, id string) {
+	idx := -1
+	for n, i := range s.incidents {
+		if i.Id == id {
+			idx = n
+		}
+	}
+	if idx < 0 {
+		writeNotFound(w)
+		return
+	}
+
+	var req incident.EditIncidentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", incident.Error{Code: "invalid_body", Message: err.Error()})
+		return
+	}
+
+	// Work on a copy, so a failed validation leaves the incident untouched.
+	i := s.incidents[idx]
+	f := req.Incident
+	if f.Name != nil {
+		i.Name = *f.Name
+	}
+	if f.Summary != nil {
+		i.Summary = *f.Summary
+	}
+	if f.SeverityID != nil {
+		sev, ok := s.severity(*f.SeverityID)
+		if !ok {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", incident.Error{
+				Code:    "invalid_value",
+				Message: "severity not found",
+				Source:  incident.ErrorSource{Field: "severity_id"},
+			})
+			return
+		}
+		i.Severity = sev
+	}
+	if f.IncidentStatusID != nil {
+		i.IncidentStatus = &incident.IncidentStatus{ID: *f.IncidentStatusID}
+	}
+
+	if len(f.CustomFieldEntries) > 0 {
+		i.CustomFieldEntries = append([]incident.CustomFieldEntry(nil), i.CustomFieldEntries...)
+	}
+	for _, e := range f.CustomFieldEntries {
+		entry, ok := s.customFieldEntry(e)
+		if !ok {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", incident.Error{
+				Code:    "invalid_value",
+				Message: "custom field or option not found",
+				Source:  incident.ErrorSource{Field: "custom_field_entries"},
+			})
+			return
+		}
+		i.CustomFieldEntries = replaceCustomFieldEntry(i.CustomFieldEntries, entry)
+	}
+
+	if len(f.IncidentRoleAssignments) > 0 {
+		i.IncidentRoleAssignments = append([]incident.IncidentRoleAssignment(nil), i.IncidentRoleAssignments...)
+	}
+	for _, a := range f.IncidentRoleAssignments {
+		role, ok := s.incidentRole(a.IncidentRoleID)
+		if !ok {
+			writeError(w, http.StatusUnprocessableEntity, "validation_error", incident.Error{
+				Code:    "invalid_value",
+				Message: "incident role not found",
+				Source:  incident.ErrorSource{Field: "incident_role_assignments"},
+			})
+			return
+		}
+		i.IncidentRoleAssignments = replaceRoleAssignment(i.IncidentRoleAssignments, role, a.Assignee)
+	}
+
+	i.UpdatedAt = time.Now().UTC()
+	s.incidents[idx] = i
+	writeJSON(w, http.StatusOK, incident.IncidentResponse{Incident: i})
+}
+
+// replaceCustomFieldEntry replaces the entry of the same custom field in
+// entries with e, or appends e. An entry without values removes the entry.
+func replaceCustomFieldEntry(entries []incident.CustomFieldEntry, e incident.CustomFieldEntry) []incident.CustomFieldEntry {
+	for n := range entries {
+		if entries[n].CustomField.Id != e.CustomField.Id {
+			continue
+		}
+		if len(e.Values) == 0 {
+			return append(entries[:n], entries[n+1:]...)
+		}
+		entries[n] = e
+		return entries
+	}
+	if len(e.Values) == 0 {
+		return entries
+	}
+	return append(entries, e)
+}
+
+// replaceRoleAssignment assigns the user referenced by assignee to role.
+// A nil assignee unassigns the role.
+func replaceRoleAssignment(assignments []incident.IncidentRoleAssignment, role incident.IncidentRole, assignee *incident.UserReference) []incident.IncidentRoleAssignment {
+	for n := range assignments {
+		if assignments[n].Role.Id == role.Id {
+			assignments = append(assignments[:n], assignments[n+1:]...)
+			break
+		}
+	}
+	if assignee == nil {
+		return assignments
+	}
+	return append(assignments, incident.IncidentRoleAssignment{
+		Role:     role,
+		Assignee: &incident.User{Id: assignee.ID, Email: assignee.Email, SlackUserID: assignee.SlackUserID},
+	})
+}
+
+func (s *Server) listActions(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	incidentID := q.Get("incident_id")
+	followUp := q.Get("is_follow_up") == "true"
+	mode := q.Get("incident_mode")
+	if mode == "" {
+		mode = incident.IncidentTypeReal
+	}
+
+	modes := map[string]string{}
+	for _, i := range s.incidents {
+		modes[i.Id] = i.Type
+	}
+
+	actions := []incident.Action{}
+	for _, a := range s.actions {
+		if incidentID != "" && a.IncidentId != incidentID {
+			continue
+		}
+		if followUp && !a.FollowUp {
+			continue
+		}
+		if m, ok := modes[a.IncidentId]; ok && m != mode {
+			continue
+		}
+		actions = append(actions, a)
+	}
+	writeJSON(w, http.StatusOK, incident.ActionsList{Actions: actions})
+}
+
+// severity returns the severity with the given id. s.mu must be held.
+func (s *Server) severity(id string) (incident.Severity, bool) {
+	for _, sev := range s.severities {
+		if sev.Id == id {
+			return sev, true
+		}
+	}
+	return incident.Severity{}, false
+}
+
+// incidentRole returns the incident role with the given id. s.mu must be held.
+func (s *Server) incidentRole(id string) (incident.IncidentRole, bool) {
+	for _, r := range s.incidentRoles {
+		if r.Id == id {
+			return r, true
+		}
+	}
+	return incident.IncidentRole{}, false
+}
+
+// customFieldEntry resolves the payload of a custom field entry into the
+// entry stored on an incident. s.mu must be held.
+func (s *Server) customFieldEntry(p incident.CustomFieldEntryPayload) (incident.CustomFieldEntry, bool) {
+	for _, f := range s.customFields {
+		if f.Id != p.CustomFieldID {
+			continue
+		}
+
+		entry := incident.CustomFieldEntry{
+			CustomField: incident.CustomFieldTypeInfo{
+				Description: f.Description,
+				FieldType:   f.FieldType,
+				Id:          f.Id,
+				Name:        f.Name,
+				Options:     f.Options,
+			},
+			Values: []incident.CustomFieldValue{},
+		}
+		for _, v := range p.Values {
+			value := incident.CustomFieldValue{
+				ValueLink:    v.ValueLink,
+				ValueNumeric: v.ValueNumeric,
+				ValueText:    v.ValueText,
+			}
+			if v.ValueOptionID != "" {
+				var found bool
+				for _, o := range f.Options {
+					if o.Id == v.ValueOptionID {
+						o := o
+						value.ValueOption = &o
+						found = true
+					}
+				}
+				if !found {
+					return incident.CustomFieldEntry{}, false
+				}
+			}
+			if v.ValueCatalogEntryID != "" {
+				value.ValueCatalogEntry = &incident.CatalogEntry{ID: v.ValueCatalogEntryID}
+			}
+			entry.Values = append(entry.Values, value)
+		}
+		return entry, true
+	}
+	return incident.CustomFieldEntry{}, false
+}
+
+// pageBounds are the bounds of a page within a list of records.
+type pageBounds struct {
+	start, end int
+}
+
+// paginate returns the bounds of the page requested by the page_size and
+// after query parameters, for a list of n records. id returns the ID of the
+// record at the given index.
+func paginate(n int, q url.Values, id func(int) string) (pageBounds, *incident.PaginationMeta, error) {
+	pageSize := defaultPageSize
+	if v := q.Get("page_size"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil || size < 1 {
+			return pageBounds{}, nil, fmt.Errorf("invalid page_size %q", v)
+		}
+		pageSize = size
+	}
+
+	start := 0
+	after := q.Get("after")
+	if after != "" {
+		start = -1
+		for i := 0; i < n; i++ {
+			if id(i) == after {
+				start = i + 1
+				break
+			}
+		}
+		if start < 0 {
+			return pageBounds{}, nil, fmt.Errorf("record %q given in after not found", after)
+		}
+	}
+
+	end := start + pageSize
+	if end > n {
+		end = n
+	}
+
+	meta := &incident.PaginationMeta{
+		After:            after,
+		PageSize:         int64(pageSize),
+		TotalRecordCount: int64(n),
+	}
+	return pageBounds{start: start, end: end}, meta, nil
+}
+
+// cutPrefix returns s without prefix and whether s started with prefix.
+func cutPrefix(s, prefix string) (string, bool) {
+	if !strings.HasPrefix(s, prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, typ string, errs ...incident.Error) {
+	if errs == nil {
+		errs = []incident.Error{}
+	}
+	writeJSON(w, status, errorBody{
+		Type:      typ,
+		Status:    status,
+		RequestID: "incidenttest",
+		Errors:    errs,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "not_found", incident.Error{Code: "not_found", Message: "Not Found"})
+}
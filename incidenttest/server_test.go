@@ -0,0 +1,197 @@
+package incidenttest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/andygrunwald/go-incident"
+	"github.com/andygrunwald/go-incident/incidenttest"
+)
+
+func TestServer_Pagination(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	var ids []string
+	for n := 1; n <= 5; n++ {
+		i := srv.AddIncident(incident.Incident{Name: fmt.Sprintf("Incident %d", n)})
+		ids = append(ids, i.Id)
+	}
+
+	tests := []struct {
+		name    string
+		opts    *incident.IncidentsListOptions
+		wantIDs []string
+	}{
+		{name: "default page size", opts: nil, wantIDs: ids},
+		{name: "first page", opts: &incident.IncidentsListOptions{PageSize: 2}, wantIDs: ids[:2]},
+		{name: "page after", opts: &incident.IncidentsListOptions{PageSize: 2, After: ids[1]}, wantIDs: ids[2:4]},
+		{name: "last page", opts: &incident.IncidentsListOptions{PageSize: 2, After: ids[3]}, wantIDs: ids[4:]},
+		{name: "after last record", opts: &incident.IncidentsListOptions{PageSize: 2, After: ids[4]}, wantIDs: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, _, err := client.Incidents.List(ctx, tt.opts)
+			if err != nil {
+				t.Fatalf("List returned error: %v", err)
+			}
+			var got []string
+			for _, i := range list.Incidents {
+				got = append(got, i.Id)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.wantIDs) {
+				t.Errorf("List returned %v, want %v", got, tt.wantIDs)
+			}
+			if list.PaginationMeta == nil || list.PaginationMeta.TotalRecordCount != 5 {
+				t.Errorf("List returned pagination meta %+v, want 5 records in total", list.PaginationMeta)
+			}
+		})
+	}
+
+	var all []string
+	err := client.Incidents.ListAll(ctx, &incident.IncidentsListOptions{PageSize: 2}, func(i incident.Incident) error {
+		all = append(all, i.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ListAll returned error: %v", err)
+	}
+	if fmt.Sprint(all) != fmt.Sprint(ids) {
+		t.Errorf("ListAll returned %v, want %v", all, ids)
+	}
+}
+
+func TestServer_Pagination_Invalid(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	tests := []struct {
+		name string
+		opts *incident.IncidentsListOptions
+	}{
+		{name: "negative page size", opts: &incident.IncidentsListOptions{PageSize: -1}},
+		{name: "unknown after", opts: &incident.IncidentsListOptions{After: "unknown"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := client.Incidents.List(context.Background(), tt.opts)
+
+			var errResp *incident.ErrorResponse
+			if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusUnprocessableEntity {
+				t.Errorf("List returned error %v, want 422 Unprocessable Entity", err)
+			}
+		})
+	}
+}
+
+func TestServer_InjectError_Times(t *testing.T) {
+	tests := []struct {
+		name      string
+		times     int
+		wantFails int
+	}{
+		{name: "zero fails once", times: 0, wantFails: 1},
+		{name: "one", times: 1, wantFails: 1},
+		{name: "three", times: 3, wantFails: 3},
+		{name: "negative fails always", times: -1, wantFails: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := incidenttest.NewServer()
+			defer srv.Close()
+			client := srv.Client()
+
+			srv.InjectError(incidenttest.InjectedError{
+				Method:     http.MethodGet,
+				Path:       "/v1/incidents",
+				StatusCode: http.StatusServiceUnavailable,
+				Type:       "service_unavailable",
+				Times:      tt.times,
+			})
+
+			fails := 0
+			for n := 0; n < 5; n++ {
+				_, _, err := client.Incidents.List(context.Background(), nil)
+				if err == nil {
+					continue
+				}
+				var errResp *incident.ErrorResponse
+				if !errors.As(err, &errResp) || errResp.Type != "service_unavailable" {
+					t.Fatalf("List returned error %v, want the injected error", err)
+				}
+				fails++
+			}
+			if fails != tt.wantFails {
+				t.Errorf("%d requests failed, want %d", fails, tt.wantFails)
+			}
+		})
+	}
+}
+
+func TestServer_InjectError_Matching(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	srv.InjectError(incidenttest.InjectedError{
+		Method:     http.MethodPost,
+		Path:       "/v1/incidents",
+		StatusCode: http.StatusInternalServerError,
+	})
+
+	// Requests with another method or path are not affected.
+	if _, _, err := client.Incidents.List(ctx, nil); err != nil {
+		t.Errorf("List returned error: %v", err)
+	}
+	if _, _, err := client.Severities.List(ctx); err != nil {
+		t.Errorf("Severities.List returned error: %v", err)
+	}
+
+	_, _, err := client.Incidents.Create(ctx, &incident.CreateIncidentRequest{IdempotencyKey: "key-1", Name: "Database is down"})
+	if err == nil {
+		t.Fatal("Create returned no error")
+	}
+	if len(srv.Incidents()) != 0 {
+		t.Errorf("failed Create stored %d incidents, want none", len(srv.Incidents()))
+	}
+}
+
+func TestServer_CreateIncident_IdempotencyKey(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+
+	first, _, err := client.Incidents.Create(ctx, &incident.CreateIncidentRequest{IdempotencyKey: "key-1", Name: "Database is down"})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	// A replayed request returns the same incident, even if the body differs.
+	replay, _, err := client.Incidents.Create(ctx, &incident.CreateIncidentRequest{IdempotencyKey: "key-1", Name: "Database is still down"})
+	if err != nil {
+		t.Fatalf("replayed Create returned error: %v", err)
+	}
+	if replay.Incident.Id != first.Incident.Id || replay.Incident.Name != "Database is down" {
+		t.Errorf("replayed Create returned %q (%s), want %q (Database is down)", replay.Incident.Name, replay.Incident.Id, first.Incident.Id)
+	}
+
+	other, _, err := client.Incidents.Create(ctx, &incident.CreateIncidentRequest{IdempotencyKey: "key-2", Name: "Queue is full"})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if other.Incident.Id == first.Incident.Id {
+		t.Errorf("Create with another key returned the incident %s of the first key", other.Incident.Id)
+	}
+
+	if got := len(srv.Incidents()); got != 2 {
+		t.Errorf("server stored %d incidents, want 2", got)
+	}
+}
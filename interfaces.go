@@ -0,0 +1,160 @@
+package incident
+
+import (
+	"context"
+)
+
+//go:generate go run ./internal/mockgen -source interfaces.go -destination incidentmock/mocks.go -package incidentmock -import github.com/andygrunwald/go-incident
+
+// The interfaces below describe the services of the Client.
+// They allow code using this library to depend on an interface
+// instead of a concrete service, e.g. to replace it in tests.
+// Mock implementations are available in package incidentmock.
+//
+// The interfaces only contain the methods that map to a single endpoint.
+// Helpers built on top of them, like iterators, are package-level
+// functions that take an interface, e.g. NewIncidentsIterator.
+
+// ActionsAPI is the interface implemented by ActionsService.
+type ActionsAPI interface {
+	List(ctx context.Context, opts *ActionsListOptions) (*ActionsList, *Response, error)
+	Get(ctx context.Context, id string) (*ActionResponse, *Response, error)
+}
+
+// AlertEventsAPI is the interface implemented by AlertEventsService.
+type AlertEventsAPI interface {
+	Create(ctx context.Context, alertSourceConfigID, token string, event *AlertEvent) (*AlertEventResponse, *Response, error)
+}
+
+// AlertSourcesAPI is the interface implemented by AlertSourcesService.
+type AlertSourcesAPI interface {
+	List(ctx context.Context) (*AlertSourcesList, *Response, error)
+	Get(ctx context.Context, id string) (*AlertSourceResponse, *Response, error)
+}
+
+// AlertsAPI is the interface implemented by AlertsService.
+type AlertsAPI interface {
+	List(ctx context.Context, opts *AlertsListOptions) (*AlertsList, *Response, error)
+	Get(ctx context.Context, id string) (*AlertResponse, *Response, error)
+	ListIncidentAlerts(ctx context.Context, opts *IncidentAlertsListOptions) (*IncidentAlertsList, *Response, error)
+}
+
+// CatalogAPI is the interface implemented by CatalogService.
+type CatalogAPI interface {
+	ListTypes(ctx context.Context) (*CatalogTypesList, *Response, error)
+	GetType(ctx context.Context, id string) (*CatalogTypeResponse, *Response, error)
+	CreateType(ctx context.Context, opts *CreateCatalogTypeRequest) (*CatalogTypeResponse, *Response, error)
+	UpdateType(ctx context.Context, id string, opts *UpdateCatalogTypeRequest) (*CatalogTypeResponse, *Response, error)
+	UpdateTypeSchema(ctx context.Context, id string, opts *UpdateCatalogTypeSchemaRequest) (*CatalogTypeResponse, *Response, error)
+	DeleteType(ctx context.Context, id string) (*Response, error)
+	ListEntries(ctx context.Context, opts *CatalogEntriesListOptions) (*CatalogEntriesList, *Response, error)
+	GetEntry(ctx context.Context, id string) (*CatalogEntryResponse, *Response, error)
+	CreateEntry(ctx context.Context, opts *CreateCatalogEntryRequest) (*CatalogEntryResponse, *Response, error)
+	UpdateEntry(ctx context.Context, id string, opts *UpdateCatalogEntryRequest) (*CatalogEntryResponse, *Response, error)
+	DeleteEntry(ctx context.Context, id string) (*Response, error)
+}
+
+// CustomFieldOptionsAPI is the interface implemented by CustomFieldOptionsService.
+type CustomFieldOptionsAPI interface {
+	List(ctx context.Context, opts *CustomFieldOptionsListOptions) (*CustomFieldOptionsList, *Response, error)
+	Get(ctx context.Context, id string) (*CustomFieldOptionResponse, *Response, error)
+	Create(ctx context.Context, opts *CreateCustomFieldOptionRequest) (*CustomFieldOptionResponse, *Response, error)
+	Update(ctx context.Context, id string, opts *UpdateCustomFieldOptionRequest) (*CustomFieldOptionResponse, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+}
+
+// CustomFieldsAPI is the interface implemented by CustomFieldsService.
+type CustomFieldsAPI interface {
+	List(ctx context.Context) (*CustomFieldsList, *Response, error)
+	Get(ctx context.Context, id string) (*CustomFieldResponse, *Response, error)
+	Create(ctx context.Context, opts *CreateCustomFieldRequest) (*CustomFieldResponse, *Response, error)
+	Update(ctx context.Context, id string, opts *UpdateCustomFieldRequest) (*CustomFieldResponse, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+}
+
+// FollowUpsAPI is the interface implemented by FollowUpsService.
+type FollowUpsAPI interface {
+	List(ctx context.Context, opts *FollowUpsListOptions) (*FollowUpsList, *Response, error)
+	Get(ctx context.Context, id string) (*FollowUpResponse, *Response, error)
+}
+
+// IncidentAttachmentsAPI is the interface implemented by IncidentAttachmentsService.
+type IncidentAttachmentsAPI interface {
+	List(ctx context.Context, opts *IncidentAttachmentsListOptions) (*IncidentAttachmentsList, *Response, error)
+	Create(ctx context.Context, opts *CreateIncidentAttachmentRequest) (*IncidentAttachmentResponse, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+}
+
+// IncidentRolesAPI is the interface implemented by IncidentRolesService.
+type IncidentRolesAPI interface {
+	List(ctx context.Context) (*IncidentRolesList, *Response, error)
+	Get(ctx context.Context, id string) (*IncidentRoleResponse, *Response, error)
+	Create(ctx context.Context, opts *CreateIncidentRoleRequest) (*IncidentRoleResponse, *Response, error)
+	Update(ctx context.Context, id string, opts *UpdateIncidentRoleRequest) (*IncidentRoleResponse, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+}
+
+// IncidentStatusesAPI is the interface implemented by IncidentStatusesService.
+type IncidentStatusesAPI interface {
+	List(ctx context.Context) (*IncidentStatusesList, *Response, error)
+	Get(ctx context.Context, id string) (*IncidentStatusResponse, *Response, error)
+	Create(ctx context.Context, opts *CreateIncidentStatusRequest) (*IncidentStatusResponse, *Response, error)
+	Update(ctx context.Context, id string, opts *UpdateIncidentStatusRequest) (*IncidentStatusResponse, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+}
+
+// IncidentTypesAPI is the interface implemented by IncidentTypesService.
+type IncidentTypesAPI interface {
+	List(ctx context.Context) (*IncidentTypesList, *Response, error)
+	Get(ctx context.Context, id string) (*IncidentTypeResponse, *Response, error)
+}
+
+// IncidentUpdatesAPI is the interface implemented by IncidentUpdatesService.
+type IncidentUpdatesAPI interface {
+	List(ctx context.Context, opts *IncidentUpdatesListOptions) (*IncidentUpdatesList, *Response, error)
+}
+
+// IncidentsAPI is the interface implemented by IncidentsService.
+type IncidentsAPI interface {
+	List(ctx context.Context, opts *IncidentsListOptions) (*IncidentsList, *Response, error)
+	Get(ctx context.Context, id string) (*IncidentResponse, *Response, error)
+	GetV2(ctx context.Context, id string) (*IncidentResponse, *Response, error)
+	Create(ctx context.Context, opts *CreateIncidentRequest) (*IncidentResponse, *Response, error)
+	Edit(ctx context.Context, id string, opts *EditIncidentRequest) (*IncidentResponse, *Response, error)
+}
+
+// SeveritiesAPI is the interface implemented by SeveritiesService.
+type SeveritiesAPI interface {
+	List(ctx context.Context) (*SeveritiesList, *Response, error)
+	Get(ctx context.Context, id string) (*SeverityResponse, *Response, error)
+	Create(ctx context.Context, opts *CreateSeverityRequest) (*SeverityResponse, *Response, error)
+	Update(ctx context.Context, id string, opts *UpdateSeverityRequest) (*SeverityResponse, *Response, error)
+	Delete(ctx context.Context, id string) (*Response, error)
+}
+
+// UsersAPI is the interface implemented by UsersService.
+type UsersAPI interface {
+	List(ctx context.Context, opts *UsersListOptions) (*UsersList, *Response, error)
+	Get(ctx context.Context, id string) (*UserResponse, *Response, error)
+	GetByEmail(ctx context.Context, email string) (*UserResponse, *Response, error)
+	GetBySlackUserID(ctx context.Context, slackUserID string) (*UserResponse, *Response, error)
+}
+
+var (
+	_ ActionsAPI             = (*ActionsService)(nil)
+	_ AlertEventsAPI         = (*AlertEventsService)(nil)
+	_ AlertSourcesAPI        = (*AlertSourcesService)(nil)
+	_ AlertsAPI              = (*AlertsService)(nil)
+	_ CatalogAPI             = (*CatalogService)(nil)
+	_ CustomFieldOptionsAPI  = (*CustomFieldOptionsService)(nil)
+	_ CustomFieldsAPI        = (*CustomFieldsService)(nil)
+	_ FollowUpsAPI           = (*FollowUpsService)(nil)
+	_ IncidentAttachmentsAPI = (*IncidentAttachmentsService)(nil)
+	_ IncidentRolesAPI       = (*IncidentRolesService)(nil)
+	_ IncidentStatusesAPI    = (*IncidentStatusesService)(nil)
+	_ IncidentTypesAPI       = (*IncidentTypesService)(nil)
+	_ IncidentUpdatesAPI     = (*IncidentUpdatesService)(nil)
+	_ IncidentsAPI           = (*IncidentsService)(nil)
+	_ SeveritiesAPI          = (*SeveritiesService)(nil)
+	_ UsersAPI               = (*UsersService)(nil)
+)
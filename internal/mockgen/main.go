@@ -0,0 +1,268 @@
+// Command mockgen generates mock implementations of the interfaces
+// declared in a Go source file.
+//
+// For every interface, a struct with the same name is generated.
+// The struct has a func field per method of the interface, named after
+// the method with a "Func" suffix. Calling a method delegates to its
+// func field and panics if the field is not set.
+//
+// Usage:
+//
+//	mockgen -source interfaces.go -destination incidentmock/mocks.go -package incidentmock -import github.com/andygrunwald/go-incident
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	source := flag.String("source", "", "Go source file declaring the interfaces")
+	destination := flag.String("destination", "", "File to write the mocks to")
+	pkg := flag.String("package", "", "Package name of the generated file")
+	importPath := flag.String("import", "", "Import path of the package declaring the interfaces")
+	flag.Parse()
+
+	if *source == "" || *destination == "" || *pkg == "" || *importPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	out, err := generate(*source, *pkg, *importPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*destination, out, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generator collects the state needed to generate the mocks of one file.
+type generator struct {
+	fset *token.FileSet
+
+	// Name of the package declaring the interfaces
+	srcPkg string
+	// Import paths by package name, as imported by the source file
+	srcImports map[string]string
+	// Import paths required by the generated code, by package name
+	imports map[string]string
+}
+
+func generate(source, pkg, importPath string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, source, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		fset:       fset,
+		srcPkg:     f.Name.Name,
+		srcImports: map[string]string{},
+		imports:    map[string]string{f.Name.Name: importPath},
+	}
+	for _, imp := range f.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		g.srcImports[name] = path
+	}
+
+	var body bytes.Buffer
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			iface, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || !ts.Name.IsExported() {
+				continue
+			}
+			if err := g.writeMock(&body, ts.Name.Name, iface); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by mockgen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&buf, "// Package %s provides mock implementations of the interfaces of package %s.\n", pkg, g.srcPkg)
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+
+	names := make([]string, 0, len(g.imports))
+	for name := range g.imports {
+		names = append(names, name)
+	}
+	// Standard library imports first, separated by a blank line from the rest
+	sort.Slice(names, func(i, j int) bool {
+		si, sj := isStd(g.imports[names[i]]), isStd(g.imports[names[j]])
+		if si != sj {
+			return si
+		}
+		return g.imports[names[i]] < g.imports[names[j]]
+	})
+	buf.WriteString("import (\n")
+	for i, name := range names {
+		path := g.imports[name]
+		if i > 0 && isStd(g.imports[names[i-1]]) && !isStd(path) {
+			buf.WriteString("\n")
+		}
+		if path[strings.LastIndex(path, "/")+1:] == name {
+			fmt.Fprintf(&buf, "\t%q\n", path)
+		} else {
+			fmt.Fprintf(&buf, "\t%s %q\n", name, path)
+		}
+	}
+	buf.WriteString(")\n\n")
+	buf.Write(body.Bytes())
+
+	return format.Source(buf.Bytes())
+}
+
+func (g *generator) writeMock(w *bytes.Buffer, name string, iface *ast.InterfaceType) error {
+	type method struct {
+		name    string
+		params  []string
+		args    []string
+		results []string
+	}
+
+	var methods []method
+	for _, field := range iface.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok {
+			return fmt.Errorf("%s: embedded interfaces are not supported", name)
+		}
+		for _, n := range field.Names {
+			m := method{name: n.Name}
+			i := 0
+			for _, p := range ft.Params.List {
+				typ := g.typeString(p.Type)
+				names := p.Names
+				if len(names) == 0 {
+					names = []*ast.Ident{nil}
+				}
+				for _, pn := range names {
+					arg := fmt.Sprintf("p%d", i)
+					if pn != nil && pn.Name != "_" {
+						arg = pn.Name
+					}
+					m.params = append(m.params, arg+" "+typ)
+					if _, ok := p.Type.(*ast.Ellipsis); ok {
+						arg += "..."
+					}
+					m.args = append(m.args, arg)
+					i++
+				}
+			}
+			if ft.Results != nil {
+				for _, r := range ft.Results.List {
+					typ := g.typeString(r.Type)
+					for j := 0; j < len(r.Names) || j == 0; j++ {
+						m.results = append(m.results, typ)
+					}
+				}
+			}
+			methods = append(methods, m)
+		}
+	}
+
+	fmt.Fprintf(w, "// %s is a mock implementation of %s.%s.\n", name, g.srcPkg, name)
+	fmt.Fprintf(w, "// Calling a method whose func field is not set panics.\n")
+	fmt.Fprintf(w, "type %s struct {\n", name)
+	for _, m := range methods {
+		fmt.Fprintf(w, "\t// %sFunc implements %s.\n", m.name, m.name)
+		fmt.Fprintf(w, "\t%sFunc func(%s) %s\n", m.name, strings.Join(m.params, ", "), results(m.results))
+	}
+	fmt.Fprintf(w, "}\n\n")
+	fmt.Fprintf(w, "var _ %s.%s = (*%s)(nil)\n\n", g.srcPkg, name, name)
+
+	for _, m := range methods {
+		fmt.Fprintf(w, "// %s calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(w, "func (m *%s) %s(%s) %s {\n", name, m.name, strings.Join(m.params, ", "), results(m.results))
+		fmt.Fprintf(w, "\tif m.%sFunc == nil {\n", m.name)
+		fmt.Fprintf(w, "\t\tpanic(\"%s: %s.%s called, but %sFunc is not set\")\n", g.srcPkg+"mock", name, m.name, m.name)
+		fmt.Fprintf(w, "\t}\n")
+		call := fmt.Sprintf("m.%sFunc(%s)", m.name, strings.Join(m.args, ", "))
+		if len(m.results) > 0 {
+			fmt.Fprintf(w, "\treturn %s\n", call)
+		} else {
+			fmt.Fprintf(w, "\t%s\n", call)
+		}
+		fmt.Fprintf(w, "}\n\n")
+	}
+	return nil
+}
+
+// isStd reports whether path is a package of the standard library.
+func isStd(path string) bool {
+	return !strings.Contains(strings.SplitN(path, "/", 2)[0], ".")
+}
+
+func results(r []string) string {
+	switch len(r) {
+	case 0:
+		return ""
+	case 1:
+		return r[0]
+	}
+	return "(" + strings.Join(r, ", ") + ")"
+}
+
+// typeString prints the type expression e, qualifying identifiers
+// declared in the source package and recording the imports needed.
+func (g *generator) typeString(e ast.Expr) string {
+	ast.Inspect(e, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if x, ok := n.X.(*ast.Ident); ok {
+				g.imports[x.Name] = g.srcImports[x.Name]
+			}
+			return false
+		case *ast.Field:
+			// Skip the names of parameters in func types
+			ast.Inspect(n.Type, func(c ast.Node) bool { return g.qualify(c) })
+			return false
+		case *ast.Ident:
+			g.qualify(n)
+		}
+		return true
+	})
+
+	var buf bytes.Buffer
+	printer.Fprint(&buf, g.fset, e)
+	return buf.String()
+}
+
+// qualify prefixes n with the source package name, if it is an exported
+// identifier of the source package.
+func (g *generator) qualify(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.SelectorExpr:
+		if x, ok := n.X.(*ast.Ident); ok {
+			g.imports[x.Name] = g.srcImports[x.Name]
+		}
+		return false
+	case *ast.Ident:
+		if n.IsExported() && !strings.Contains(n.Name, ".") {
+			n.Name = g.srcPkg + "." + n.Name
+		}
+	}
+	return true
+}
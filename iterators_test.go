@@ -0,0 +1,107 @@
+package incident_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andygrunwald/go-incident"
+	"github.com/andygrunwald/go-incident/incidentmock"
+)
+
+func TestListAllUsers_Mock(t *testing.T) {
+	pages := map[string][]incident.User{
+		"":   {{Id: "u1"}, {Id: "u2"}},
+		"u2": {{Id: "u3"}},
+	}
+	users := &incidentmock.UsersAPI{
+		ListFunc: func(ctx context.Context, opts *incident.UsersListOptions) (*incident.UsersList, *incident.Response, error) {
+			return &incident.UsersList{
+				Users:          pages[opts.After],
+				PaginationMeta: &incident.PaginationMeta{PageSize: 2},
+			}, nil, nil
+		},
+	}
+
+	var got []string
+	err := incident.ListAllUsers(context.Background(), users, nil, func(u incident.User) error {
+		got = append(got, u.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ListAllUsers returned error: %v", err)
+	}
+	if want := []string{"u1", "u2", "u3"}; !equalStrings(got, want) {
+		t.Errorf("ListAllUsers returned %v, want %v", got, want)
+	}
+}
+
+func TestNewIterators_Mock(t *testing.T) {
+	ctx := context.Background()
+
+	alerts := &incidentmock.AlertsAPI{
+		ListFunc: func(ctx context.Context, opts *incident.AlertsListOptions) (*incident.AlertsList, *incident.Response, error) {
+			return &incident.AlertsList{Alerts: []incident.Alert{{ID: "a1"}}}, nil, nil
+		},
+		ListIncidentAlertsFunc: func(ctx context.Context, opts *incident.IncidentAlertsListOptions) (*incident.IncidentAlertsList, *incident.Response, error) {
+			return &incident.IncidentAlertsList{IncidentAlerts: []incident.IncidentAlert{{ID: "ia1"}}}, nil, nil
+		},
+	}
+	catalog := &incidentmock.CatalogAPI{
+		ListEntriesFunc: func(ctx context.Context, opts *incident.CatalogEntriesListOptions) (*incident.CatalogEntriesList, *incident.Response, error) {
+			return &incident.CatalogEntriesList{CatalogEntries: []incident.CatalogEntry{{ID: "e1"}}}, nil, nil
+		},
+	}
+	options := &incidentmock.CustomFieldOptionsAPI{
+		ListFunc: func(ctx context.Context, opts *incident.CustomFieldOptionsListOptions) (*incident.CustomFieldOptionsList, *incident.Response, error) {
+			return &incident.CustomFieldOptionsList{CustomFieldOptions: []incident.CustomFieldOption{{Id: "o1"}}}, nil, nil
+		},
+	}
+	updates := &incidentmock.IncidentUpdatesAPI{
+		ListFunc: func(ctx context.Context, opts *incident.IncidentUpdatesListOptions) (*incident.IncidentUpdatesList, *incident.Response, error) {
+			return &incident.IncidentUpdatesList{IncidentUpdates: []incident.IncidentUpdate{{ID: "iu1"}}}, nil, nil
+		},
+	}
+
+	tests := []struct {
+		name string
+		iter func() (string, bool, error)
+		want string
+	}{
+		{"alerts", func() (string, bool, error) {
+			it := incident.NewAlertsIterator(alerts, nil)
+			ok := it.Next(ctx)
+			return it.Alert().ID, ok, it.Err()
+		}, "a1"},
+		{"incident alerts", func() (string, bool, error) {
+			it := incident.NewIncidentAlertsIterator(alerts, nil)
+			ok := it.Next(ctx)
+			return it.IncidentAlert().ID, ok, it.Err()
+		}, "ia1"},
+		{"catalog entries", func() (string, bool, error) {
+			it := incident.NewCatalogEntriesIterator(catalog, nil)
+			ok := it.Next(ctx)
+			return it.CatalogEntry().ID, ok, it.Err()
+		}, "e1"},
+		{"custom field options", func() (string, bool, error) {
+			it := incident.NewCustomFieldOptionsIterator(options, nil)
+			ok := it.Next(ctx)
+			return it.CustomFieldOption().Id, ok, it.Err()
+		}, "o1"},
+		{"incident updates", func() (string, bool, error) {
+			it := incident.NewIncidentUpdatesIterator(updates, nil)
+			ok := it.Next(ctx)
+			return it.IncidentUpdate().ID, ok, it.Err()
+		}, "iu1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := tt.iter()
+			if !ok || err != nil {
+				t.Fatalf("Next returned %v with error %v, want true", ok, err)
+			}
+			if got != tt.want {
+				t.Errorf("iterator returned %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
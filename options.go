@@ -0,0 +1,194 @@
+package incident
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Option configures a Client created by NewClientWithOptions.
+type Option func(*clientOptions) error
+
+// clientOptions collects the configuration of all options,
+// so that the order of options does not matter.
+type clientOptions struct {
+	httpClient       *http.Client
+	baseURL          *url.URL
+	userAgent        *string
+	retryPolicy      *RetryPolicy
+	waitForRateLimit bool
+	logger           Logger
+	timeout          time.Duration
+	middleware       []func(http.RoundTripper) http.RoundTripper
+}
+
+// Logger logs the requests, retries and rate limit waits of a Client.
+// It is implemented by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// NewClientWithOptions returns a new Incident.io API client configured by opts.
+// All endpoints require authentication, the apiKey should be set.
+//
+// Unlike NewClient, the configuration is validated once at construction.
+// An error is returned if an option is invalid.
+//
+//	client, err := incident.NewClientWithOptions(apiKey,
+//		incident.WithTimeout(30*time.Second),
+//		incident.WithRetry(incident.DefaultRetryPolicy()),
+//	)
+func NewClientWithOptions(apiKey string, opts ...Option) (*Client, error) {
+	o := &clientOptions{}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+
+	// Work on a copy, so the http.Client of the caller is not modified.
+	httpClient := &http.Client{}
+	if o.httpClient != nil {
+		clientCopy := *o.httpClient
+		httpClient = &clientCopy
+	}
+	if o.timeout > 0 {
+		httpClient.Timeout = o.timeout
+	}
+	if len(o.middleware) > 0 {
+		transport := httpClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+		// Wrap in reverse order, so that the first middleware is the outermost one.
+		for i := len(o.middleware) - 1; i >= 0; i-- {
+			transport = o.middleware[i](transport)
+		}
+		httpClient.Transport = transport
+	}
+
+	c := NewClient(apiKey, httpClient)
+	if o.baseURL != nil {
+		c.BaseURL = o.baseURL
+	}
+	c.validatedBaseURL = c.BaseURL
+	if o.userAgent != nil {
+		c.UserAgent = *o.userAgent
+	}
+	c.retryPolicy = o.retryPolicy
+	c.waitForRateLimit = o.waitForRateLimit
+	c.logger = o.logger
+
+	return c, nil
+}
+
+// WithBaseURL sets the base URL for API requests.
+// The URL must be absolute and end with a trailing slash,
+// e.g. "https://api.incident.io/v1/".
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return fmt.Errorf("invalid base URL: %w", err)
+		}
+		if !u.IsAbs() || u.Host == "" {
+			return fmt.Errorf("base URL must be absolute, but %q is not", baseURL)
+		}
+		if !strings.HasSuffix(u.Path, "/") {
+			return fmt.Errorf("base URL must have a trailing slash, but %q does not", baseURL)
+		}
+		o.baseURL = u
+		return nil
+	}
+}
+
+// WithUserAgent sets the user agent used when communicating with the API.
+// An empty user agent omits the header.
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) error {
+		o.userAgent = &userAgent
+		return nil
+	}
+}
+
+// WithHTTPClient sets the http.Client used to communicate with the API.
+// The client is copied, other options don't modify it.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(o *clientOptions) error {
+		if httpClient == nil {
+			return errors.New("http client must be non-nil")
+		}
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTimeout sets the time limit for a single request, including
+// reading the response body. See http.Client.Timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *clientOptions) error {
+		if timeout < 0 {
+			return fmt.Errorf("timeout must not be negative, but is %v", timeout)
+		}
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithRetry sets the policy used to retry failed requests.
+// A non-nil policy must allow at least one attempt and have
+// non-negative backoffs, MaxBackoff not lower than MinBackoff if set.
+// See Client.SetRetryPolicy.
+func WithRetry(p *RetryPolicy) Option {
+	return func(o *clientOptions) error {
+		if p != nil {
+			if p.MaxAttempts < 1 {
+				return fmt.Errorf("retry attempts must be at least 1, but is %d", p.MaxAttempts)
+			}
+			if p.MinBackoff < 0 {
+				return fmt.Errorf("retry backoff must not be negative, but is %v", p.MinBackoff)
+			}
+			if p.MaxBackoff < 0 {
+				return fmt.Errorf("maximum retry backoff must not be negative, but is %v", p.MaxBackoff)
+			}
+			if p.MaxBackoff > 0 && p.MaxBackoff < p.MinBackoff {
+				return fmt.Errorf("maximum retry backoff %v must not be lower than the minimum backoff %v", p.MaxBackoff, p.MinBackoff)
+			}
+		}
+		o.retryPolicy = p
+		return nil
+	}
+}
+
+// WithWaitForRateLimit makes the client wait until the rate limit resets,
+// once it is exhausted. See Client.SetWaitForRateLimit.
+func WithWaitForRateLimit() Option {
+	return func(o *clientOptions) error {
+		o.waitForRateLimit = true
+		return nil
+	}
+}
+
+// WithLogger sets the logger for requests, retries and rate limit waits.
+func WithLogger(l Logger) Option {
+	return func(o *clientOptions) error {
+		o.logger = l
+		return nil
+	}
+}
+
+// WithMiddleware wraps the http.RoundTripper of the client with mw,
+// e.g. to add tracing or metrics. Middleware is applied in the order
+// of the options, the first one being the outermost.
+func WithMiddleware(mw func(http.RoundTripper) http.RoundTripper) Option {
+	return func(o *clientOptions) error {
+		if mw == nil {
+			return errors.New("middleware must be non-nil")
+		}
+		o.middleware = append(o.middleware, mw)
+		return nil
+	}
+}
@@ -0,0 +1,151 @@
+package incident
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestWithBaseURL(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		wantErr bool
+	}{
+		{baseURL: "https://api.incident.io/v1/"},
+		{baseURL: "http://127.0.0.1:8080/v1/"},
+		{baseURL: "https://api.incident.io/v1", wantErr: true},
+		{baseURL: "https://api.incident.io", wantErr: true},
+		{baseURL: "/v1/", wantErr: true},
+		{baseURL: "api.incident.io/v1/", wantErr: true},
+		{baseURL: "https://[::1/v1/", wantErr: true},
+	}
+	for _, tt := range tests {
+		c, err := NewClientWithOptions("key", WithBaseURL(tt.baseURL))
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("WithBaseURL(%q) returned no error", tt.baseURL)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("WithBaseURL(%q) returned error: %v", tt.baseURL, err)
+			continue
+		}
+		if got := c.BaseURL.String(); got != tt.baseURL {
+			t.Errorf("BaseURL = %q, want %q", got, tt.baseURL)
+		}
+	}
+}
+
+func TestNewRequest_ReplacedBaseURL(t *testing.T) {
+	c, err := NewClientWithOptions("key", WithBaseURL("https://api.incident.io/v1/"))
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	if _, err := c.NewRequest("GET", "incidents", nil); err != nil {
+		t.Errorf("NewRequest returned error: %v", err)
+	}
+
+	c.BaseURL, _ = url.Parse("https://api.incident.io/v1")
+	if _, err := c.NewRequest("GET", "incidents", nil); err == nil {
+		t.Error("NewRequest returned no error for a replaced BaseURL without trailing slash")
+	}
+}
+
+func TestWithMiddleware_Order(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{}`)
+	}))
+	defer srv.Close()
+
+	var calls []string
+	record := func(name string) func(http.RoundTripper) http.RoundTripper {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" before")
+				resp, err := next.RoundTrip(r)
+				calls = append(calls, name+" after")
+				return resp, err
+			})
+		}
+	}
+
+	c, err := NewClientWithOptions("key",
+		WithBaseURL(srv.URL+"/v1/"),
+		WithMiddleware(record("first")),
+		WithMiddleware(record("second")),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+	req, _ := c.NewRequest(http.MethodGet, "incidents", nil)
+	if _, err := c.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	want := []string{"first before", "second before", "second after", "first after"}
+	if len(calls) != len(want) {
+		t.Fatalf("middleware calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("middleware calls = %v, want %v", calls, want)
+			break
+		}
+	}
+}
+
+func TestWithHTTPClient_NotModified(t *testing.T) {
+	transport := &http.Transport{}
+	httpClient := &http.Client{Transport: transport, Timeout: time.Minute}
+
+	c, err := NewClientWithOptions("key",
+		WithHTTPClient(httpClient),
+		WithTimeout(5*time.Second),
+		WithMiddleware(func(next http.RoundTripper) http.RoundTripper { return next }),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if httpClient.Timeout != time.Minute || httpClient.Transport != transport {
+		t.Errorf("caller's http.Client was modified: %+v", httpClient)
+	}
+	if c.client == httpClient {
+		t.Fatal("client uses the caller's http.Client, want a copy")
+	}
+	if c.client.Timeout != 5*time.Second {
+		t.Errorf("client timeout = %v, want 5s", c.client.Timeout)
+	}
+}
+
+func TestNewClientWithOptions_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  Option
+	}{
+		{name: "nil http client", opt: WithHTTPClient(nil)},
+		{name: "negative timeout", opt: WithTimeout(-time.Second)},
+		{name: "negative backoff", opt: WithRetry(&RetryPolicy{MaxAttempts: 3, MinBackoff: -time.Second})},
+		{name: "no retry attempts", opt: WithRetry(&RetryPolicy{MaxAttempts: 0})},
+		{name: "negative max backoff", opt: WithRetry(&RetryPolicy{MaxAttempts: 3, MaxBackoff: -time.Second})},
+		{name: "max backoff below min backoff", opt: WithRetry(&RetryPolicy{MaxAttempts: 3, MinBackoff: time.Second, MaxBackoff: time.Millisecond})},
+		{name: "nil middleware", opt: WithMiddleware(nil)},
+	}
+	for _, tt := range tests {
+		if _, err := NewClientWithOptions("key", tt.opt); err == nil {
+			t.Errorf("%s: NewClientWithOptions returned no error", tt.name)
+		}
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
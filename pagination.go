@@ -0,0 +1,96 @@
+package incident
+
+import "context"
+
+// cursorPager iterates over the records of a list endpoint that paginates
+// with an After cursor, fetching one page at a time.
+// It backs the exported iterators, like IncidentsIterator.
+type cursorPager[T any] struct {
+	// fetch returns the page of records following the record after.
+	fetch func(ctx context.Context, after string) ([]T, *PaginationMeta, error)
+
+	// id returns the identifier of a record, used as cursor for the next page.
+	id func(T) string
+
+	after   string
+	page    []T
+	current T
+	done    bool
+	err     error
+}
+
+func newCursorPager[T any](after string, fetch func(ctx context.Context, after string) ([]T, *PaginationMeta, error), id func(T) string) *cursorPager[T] {
+	return &cursorPager[T]{
+		fetch: fetch,
+		id:    id,
+		after: after,
+	}
+}
+
+// next advances to the next record, fetching the next page if required.
+// It returns false when there are no more records or an error occurred.
+func (p *cursorPager[T]) next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+	if ctx == nil {
+		p.err = errNonNilContext
+		return false
+	}
+	if err := ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+
+	if len(p.page) == 0 {
+		if p.done {
+			return false
+		}
+
+		records, meta, err := p.fetch(ctx, p.after)
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		// An empty page means we reached the end.
+		if len(records) == 0 {
+			p.done = true
+			return false
+		}
+
+		// A page that is not full is the last one.
+		// This saves a request that would return an empty page.
+		if meta != nil && meta.PageSize > 0 && int64(len(records)) < meta.PageSize {
+			p.done = true
+		}
+
+		// Guard against a cursor that does not advance to avoid looping forever.
+		// A page ending at the cursor repeats the previous page and is dropped.
+		last := p.id(records[len(records)-1])
+		if p.after != "" && last == p.after {
+			p.done = true
+			return false
+		}
+		if last == "" {
+			p.done = true
+		}
+		p.after = last
+		p.page = records
+	}
+
+	p.current = p.page[0]
+	p.page = p.page[1:]
+	return true
+}
+
+// forEach calls fn for every record of p.
+// Iteration stops at the first error returned by fn or p.
+func forEach[T any](ctx context.Context, p *cursorPager[T], fn func(T) error) error {
+	for p.next(ctx) {
+		if err := fn(p.current); err != nil {
+			return err
+		}
+	}
+	return p.err
+}
@@ -0,0 +1,142 @@
+package incident
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+// fakePages serves records "1" to "n" in pages of pageSize,
+// like a list endpoint paginating with an After cursor.
+type fakePages struct {
+	n        int
+	pageSize int
+	meta     bool
+	stuck    bool
+	failAt   int
+	requests int
+}
+
+var errFetch = errors.New("fetch failed")
+
+func (f *fakePages) fetch(ctx context.Context, after string) ([]string, *PaginationMeta, error) {
+	f.requests++
+	if f.failAt > 0 && f.requests == f.failAt {
+		return nil, nil, errFetch
+	}
+
+	start := 0
+	if after != "" && !f.stuck {
+		start, _ = strconv.Atoi(after)
+	}
+	var page []string
+	for i := start + 1; i <= f.n && len(page) < f.pageSize; i++ {
+		page = append(page, strconv.Itoa(i))
+	}
+
+	var meta *PaginationMeta
+	if f.meta {
+		meta = &PaginationMeta{After: after, PageSize: int64(f.pageSize)}
+	}
+	return page, meta, nil
+}
+
+func TestCursorPager(t *testing.T) {
+	tests := []struct {
+		name         string
+		pages        *fakePages
+		wantRecords  int
+		wantRequests int
+		wantErr      error
+	}{
+		{name: "empty", pages: &fakePages{n: 0, pageSize: 2, meta: true}, wantRecords: 0, wantRequests: 1},
+		{name: "partial last page", pages: &fakePages{n: 5, pageSize: 2, meta: true}, wantRecords: 5, wantRequests: 3},
+		{name: "full last page", pages: &fakePages{n: 4, pageSize: 2, meta: true}, wantRecords: 4, wantRequests: 3},
+		{name: "without pagination meta", pages: &fakePages{n: 5, pageSize: 2}, wantRecords: 5, wantRequests: 4},
+		{name: "cursor does not advance", pages: &fakePages{n: 5, pageSize: 2, stuck: true}, wantRecords: 2, wantRequests: 2},
+		{name: "error on second page", pages: &fakePages{n: 5, pageSize: 2, meta: true, failAt: 2}, wantRecords: 2, wantRequests: 2, wantErr: errFetch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newCursorPager("", tt.pages.fetch, func(s string) string { return s })
+
+			var got []string
+			err := forEach(context.Background(), p, func(s string) error {
+				got = append(got, s)
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("forEach returned %v, want %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantRecords {
+				t.Errorf("forEach visited %d records, want %d", len(got), tt.wantRecords)
+			}
+			// Records are visited once and in order.
+			for i, s := range got {
+				if want := strconv.Itoa(i + 1); s != want {
+					t.Errorf("record %d is %q, want %q", i, s, want)
+				}
+			}
+			if tt.pages.requests != tt.wantRequests {
+				t.Errorf("pager fetched %d pages, want %d", tt.pages.requests, tt.wantRequests)
+			}
+
+			// An exhausted pager does not fetch again.
+			if p.next(context.Background()) {
+				t.Errorf("next returned true after the last record")
+			}
+			if tt.pages.requests != tt.wantRequests {
+				t.Errorf("exhausted pager fetched another page")
+			}
+		})
+	}
+}
+
+func TestCursorPager_StartsAfterCursor(t *testing.T) {
+	pages := &fakePages{n: 5, pageSize: 10, meta: true}
+	p := newCursorPager("3", pages.fetch, func(s string) string { return s })
+
+	var got []string
+	forEach(context.Background(), p, func(s string) error {
+		got = append(got, s)
+		return nil
+	})
+	if len(got) != 2 || got[0] != "4" || got[1] != "5" {
+		t.Errorf("forEach visited %v, want [4 5]", got)
+	}
+}
+
+func TestCursorPager_CallbackError(t *testing.T) {
+	pages := &fakePages{n: 5, pageSize: 2, meta: true}
+	p := newCursorPager("", pages.fetch, func(s string) string { return s })
+
+	errStop := errors.New("stop")
+	var got int
+	err := forEach(context.Background(), p, func(string) error {
+		got++
+		if got == 3 {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) || got != 3 {
+		t.Errorf("forEach returned %v after %d records, want %v after 3", err, got, errStop)
+	}
+}
+
+func TestCursorPager_ContextCanceled(t *testing.T) {
+	pages := &fakePages{n: 5, pageSize: 2, meta: true}
+	p := newCursorPager("", pages.fetch, func(s string) string { return s })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var got int
+	err := forEach(ctx, p, func(string) error {
+		got++
+		cancel()
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) || got != 1 {
+		t.Errorf("forEach returned %v after %d records, want %v after 1", err, got, context.Canceled)
+	}
+}
@@ -0,0 +1,149 @@
+package incident
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+	headerRetryAfter    = "Retry-After"
+)
+
+// Rate represents the rate limit for the current client,
+// as reported by the Incident.io API.
+//
+// API docs: https://api-docs.incident.io/#section/Making-requests/Rate-limits
+type Rate struct {
+	// The number of requests per minute the client is currently limited to.
+	Limit int `json:"limit"`
+
+	// The number of remaining requests the client can make this minute.
+	Remaining int `json:"remaining"`
+
+	// The time at which the current rate limit will reset.
+	Reset time.Time `json:"reset"`
+}
+
+func (r Rate) String() string {
+	return fmt.Sprintf("%d/%d, resets at %v", r.Remaining, r.Limit, r.Reset)
+}
+
+// parseRate parses the rate related headers of r.
+// Headers that are missing or malformed are left at their zero value.
+func parseRate(r *http.Response) Rate {
+	var rate Rate
+	if limit := r.Header.Get(headerRateLimit); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
+	}
+	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+	if reset := r.Header.Get(headerRateReset); reset != "" {
+		rate.Reset = parseTimeHeader(reset)
+	}
+	if rate.Reset.IsZero() {
+		if after := r.Header.Get(headerRetryAfter); after != "" {
+			if secs, err := strconv.ParseInt(after, 10, 64); err == nil {
+				rate.Reset = time.Now().Add(time.Duration(secs) * time.Second)
+			} else {
+				rate.Reset = parseTimeHeader(after)
+			}
+		}
+	}
+	return rate
+}
+
+// parseTimeHeader parses a header value that is either a unix timestamp
+// or a formatted date. It returns the zero time if v can't be parsed.
+func parseTimeHeader(v string) time.Time {
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(secs, 0)
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t
+	}
+	return time.Time{}
+}
+
+// RateLimitError occurs when the Incident.io API returns
+// 429 Too Many Requests.
+type RateLimitError struct {
+	// Rate specifies the last known rate limit for the client
+	Rate Rate
+
+	// HTTP response that caused this error
+	Response *http.Response
+
+	// Error details provided by the API
+	ErrorResponse *ErrorResponse
+}
+
+func (r *RateLimitError) Error() string {
+	return fmt.Sprintf("%v %v: %d API rate limit exceeded, resets at %v",
+		r.Response.Request.Method, r.Response.Request.URL,
+		r.Response.StatusCode, r.Rate.Reset)
+}
+
+// Unwrap returns the underlying ErrorResponse, so that
+// errors.As can be used to access the API error details.
+func (r *RateLimitError) Unwrap() error {
+	return r.ErrorResponse
+}
+
+// SetWaitForRateLimit configures whether the client blocks requests
+// while the rate limit is exhausted. If enabled, a request made after the
+// API reported no remaining requests waits until the rate limit resets,
+// instead of being rejected by the API.
+func (c *Client) SetWaitForRateLimit(wait bool) {
+	c.clientMu.Lock()
+	defer c.clientMu.Unlock()
+	c.waitForRateLimit = wait
+}
+
+// RateLimit returns the rate limit reported by the most recent
+// API response.
+func (c *Client) RateLimit() Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	return c.rateLimit
+}
+
+// updateRateLimit stores the rate limit reported by an API response.
+func (c *Client) updateRateLimit(rate Rate) {
+	if rate.Limit == 0 && rate.Reset.IsZero() {
+		return
+	}
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	c.rateLimit = rate
+}
+
+// waitForRateLimitReset blocks until the rate limit resets, if waiting
+// is enabled and the rate limit is exhausted.
+func (c *Client) waitForRateLimitReset(ctx context.Context) error {
+	c.clientMu.Lock()
+	wait := c.waitForRateLimit
+	c.clientMu.Unlock()
+	if !wait {
+		return nil
+	}
+
+	rate := c.RateLimit()
+	if rate.Remaining > 0 || rate.Reset.IsZero() {
+		return nil
+	}
+	d := time.Until(rate.Reset)
+	if d > 0 {
+		c.logf("go-incident: rate limit exhausted, waiting %v until it resets", d)
+	}
+	return sleep(ctx, d)
+}
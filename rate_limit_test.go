@@ -0,0 +1,192 @@
+package incident_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/andygrunwald/go-incident"
+	"github.com/andygrunwald/go-incident/incidenttest"
+)
+
+func TestRateLimitError_Headers(t *testing.T) {
+	reset := time.Now().Add(30 * time.Second).Truncate(time.Second)
+
+	tests := []struct {
+		name          string
+		header        http.Header
+		wantLimit     int
+		wantRemaining int
+		wantReset     time.Time
+	}{
+		{
+			name: "unix timestamp",
+			header: http.Header{
+				"X-Ratelimit-Limit":     {"1200"},
+				"X-Ratelimit-Remaining": {"0"},
+				"X-Ratelimit-Reset":     {strconv.FormatInt(reset.Unix(), 10)},
+			},
+			wantLimit: 1200,
+			wantReset: reset,
+		},
+		{
+			name: "HTTP date",
+			header: http.Header{
+				"X-Ratelimit-Limit": {"1200"},
+				"X-Ratelimit-Reset": {reset.UTC().Format(http.TimeFormat)},
+			},
+			wantLimit: 1200,
+			wantReset: reset,
+		},
+		{
+			name: "RFC 3339",
+			header: http.Header{
+				"X-Ratelimit-Reset": {reset.Format(time.RFC3339)},
+			},
+			wantReset: reset,
+		},
+		{
+			name: "Retry-After in seconds",
+			header: http.Header{
+				"Retry-After": {"30"},
+			},
+			wantReset: reset,
+		},
+		{
+			name: "Retry-After as HTTP date",
+			header: http.Header{
+				"Retry-After": {reset.UTC().Format(http.TimeFormat)},
+			},
+			wantReset: reset,
+		},
+		{
+			name: "reset header takes precedence over Retry-After",
+			header: http.Header{
+				"X-Ratelimit-Reset": {strconv.FormatInt(reset.Unix(), 10)},
+				"Retry-After":       {"3600"},
+			},
+			wantReset: reset,
+		},
+		{
+			name: "malformed headers",
+			header: http.Header{
+				"X-Ratelimit-Limit":     {"many"},
+				"X-Ratelimit-Remaining": {"few"},
+				"X-Ratelimit-Reset":     {"soon"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := incidenttest.NewServer()
+			defer srv.Close()
+			srv.InjectError(incidenttest.InjectedError{
+				StatusCode: http.StatusTooManyRequests,
+				Type:       "rate_limited",
+				Header:     tt.header,
+			})
+
+			_, resp, err := srv.Client().Incidents.List(context.Background(), nil)
+
+			var rateErr *incident.RateLimitError
+			if !errors.As(err, &rateErr) {
+				t.Fatalf("List returned error %v, want *incident.RateLimitError", err)
+			}
+			var errResp *incident.ErrorResponse
+			if !errors.As(err, &errResp) || errResp.Type != "rate_limited" {
+				t.Errorf("RateLimitError does not unwrap to the API error, got %v", errResp)
+			}
+
+			got := rateErr.Rate
+			if got.Limit != tt.wantLimit || got.Remaining != tt.wantRemaining {
+				t.Errorf("Rate is %d/%d, want %d/%d", got.Remaining, got.Limit, tt.wantRemaining, tt.wantLimit)
+			}
+			// Retry-After in seconds is relative to the time of the response.
+			if d := got.Reset.Sub(tt.wantReset); d < -2*time.Second || d > 2*time.Second {
+				t.Errorf("Rate.Reset is %v, want %v", got.Reset, tt.wantReset)
+			}
+			if tt.wantReset.IsZero() && !got.Reset.IsZero() {
+				t.Errorf("Rate.Reset is %v, want zero time", got.Reset)
+			}
+			if resp == nil || resp.Rate != got {
+				t.Errorf("Response.Rate is %v, want %v", resp, got)
+			}
+		})
+	}
+}
+
+func TestClient_RateLimit(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	srv.InjectError(incidenttest.InjectedError{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"X-Ratelimit-Limit": {"1200"}, "X-Ratelimit-Remaining": {"0"}, "Retry-After": {"60"}},
+	})
+	client.Incidents.List(context.Background(), nil)
+
+	if got := client.RateLimit(); got.Limit != 1200 || got.Remaining != 0 {
+		t.Errorf("RateLimit is %v, want 0/1200", got)
+	}
+
+	// Responses without rate limit headers keep the last known rate limit.
+	if _, _, err := client.Incidents.List(context.Background(), nil); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if got := client.RateLimit(); got.Limit != 1200 {
+		t.Errorf("RateLimit is %v after response without headers, want 0/1200", got)
+	}
+}
+
+func TestClient_WaitForRateLimit(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	client := srv.Client(incident.WithWaitForRateLimit())
+
+	srv.InjectError(incidenttest.InjectedError{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"X-Ratelimit-Remaining": {"0"}, "Retry-After": {"1"}},
+	})
+	if _, _, err := client.Incidents.List(context.Background(), nil); err == nil {
+		t.Fatal("List returned no error, want injected rate limit error")
+	}
+
+	// The next request waits until the rate limit resets.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, _, err := client.Incidents.List(ctx, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("List returned error %v while waiting, want %v", err, context.DeadlineExceeded)
+	}
+
+	start := time.Now()
+	if _, _, err := client.Incidents.List(context.Background(), nil); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if waited := time.Since(start); waited < 500*time.Millisecond {
+		t.Errorf("List waited %v, want it to wait until the rate limit resets", waited)
+	}
+}
+
+func TestClient_RetryUntilRateLimitResets(t *testing.T) {
+	srv := incidenttest.NewServer()
+	defer srv.Close()
+	client := srv.Client(incident.WithRetry(&incident.RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond}))
+
+	srv.InjectError(incidenttest.InjectedError{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": {"1"}},
+	})
+
+	start := time.Now()
+	if _, _, err := client.Incidents.List(context.Background(), nil); err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if waited := time.Since(start); waited < 900*time.Millisecond {
+		t.Errorf("retry happened after %v, want it to wait for the rate limit reset", waited)
+	}
+}
@@ -0,0 +1,209 @@
+package incident
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// retryableKey is the context key marking a request as safe to retry,
+// even if its HTTP method is not idempotent. See MarkRetryable.
+type retryableKey struct{}
+
+// MarkRetryable returns a shallow copy of req that the RetryPolicy of the
+// Client retries, even if its HTTP method is not idempotent.
+// Only mark requests the API deduplicates, e.g. by an idempotency key in
+// the body. The mark is not sent to the API.
+//
+// This is useful for requests built with Client.NewRequest:
+//
+//	req, err := client.NewRequest("POST", "incidents", body)
+//	if err != nil {
+//		return err
+//	}
+//	resp, err := client.Do(ctx, incident.MarkRetryable(req), v)
+func MarkRetryable(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), retryableKey{}, true))
+}
+
+// isMarkedRetryable reports whether req was marked by MarkRetryable.
+func isMarkedRetryable(req *http.Request) bool {
+	v, _ := req.Context().Value(retryableKey{}).(bool)
+	return v
+}
+
+// DefaultRetryableStatusCodes are the HTTP status codes retried by a
+// RetryPolicy without RetryableStatusCodes.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy configures how Client.BareDo retries requests that failed
+// due to network errors or a retryable HTTP status code.
+//
+// Only requests with an idempotent HTTP method (GET, HEAD, OPTIONS, TRACE,
+// PUT, DELETE) are retried, plus requests the API deduplicates, like
+// IncidentsService.Create with its idempotency key, or marked with
+// MarkRetryable. This ensures that a retry never performs an action twice.
+type RetryPolicy struct {
+	// Maximum number of attempts, including the first one.
+	// Values lower than 2 disable retries.
+	MaxAttempts int
+
+	// Backoff before the first retry. It doubles with every further attempt.
+	MinBackoff time.Duration
+
+	// Upper bound of the backoff between two attempts.
+	// If not set, the backoff keeps doubling.
+	MaxBackoff time.Duration
+
+	// HTTP status codes that are retried.
+	// If empty, DefaultRetryableStatusCodes is used.
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy returns a RetryPolicy with three attempts and
+// an exponential backoff between 500ms and 10s.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  10 * time.Second,
+	}
+}
+
+// SetRetryPolicy sets the policy used to retry failed requests.
+// A nil policy disables retries, which is the default.
+func (c *Client) SetRetryPolicy(p *RetryPolicy) {
+	c.clientMu.Lock()
+	defer c.clientMu.Unlock()
+	c.retryPolicy = p
+}
+
+// retryPolicyFor returns the retry policy to apply to req,
+// or nil if req must not be retried. retryable marks req as safe to retry,
+// regardless of its HTTP method.
+func (c *Client) retryPolicyFor(req *http.Request, retryable bool) *RetryPolicy {
+	c.clientMu.Lock()
+	p := c.retryPolicy
+	c.clientMu.Unlock()
+
+	if p == nil || p.MaxAttempts < 2 {
+		return nil
+	}
+
+	// A body that can't be rewound can't be sent a second time.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return nil
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete, http.MethodTrace:
+		return p
+	}
+	if retryable {
+		return p
+	}
+	return nil
+}
+
+// shouldRetry reports whether an attempt that resulted in resp and err
+// should be retried.
+func (p *RetryPolicy) shouldRetry(ctx context.Context, resp *Response, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// The caller gave up, there is no point in trying again.
+	if ctx.Err() != nil {
+		return false
+	}
+
+	// No response at all means a network error.
+	if resp == nil {
+		return true
+	}
+
+	codes := p.RetryableStatusCodes
+	if len(codes) == 0 {
+		codes = DefaultRetryableStatusCodes
+	}
+	for _, c := range codes {
+		if resp.StatusCode == c {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the time to wait before the given retry.
+// retry starts at 1 for the first retry.
+// Jitter spreads the delay between half and the full backoff,
+// to avoid many clients retrying at the same time.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := p.MinBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		// Stop doubling before d overflows, if MaxBackoff doesn't bound it.
+		if d > math.MaxInt64/2 {
+			break
+		}
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	half := int64(d / 2)
+	return time.Duration(half + jitter(half+1))
+}
+
+var (
+	jitterMu   sync.Mutex
+	jitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// jitter returns a random number in [0, n).
+func jitter(n int64) int64 {
+	jitterMu.Lock()
+	defer jitterMu.Unlock()
+	return jitterRand.Int63n(n)
+}
+
+// rewindBody resets the body of req, so it can be sent again.
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+// sleep waits for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
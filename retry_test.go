@@ -0,0 +1,280 @@
+package incident
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	tests := []struct {
+		retry int
+		want  time.Duration
+	}{
+		{retry: 1, want: 100 * time.Millisecond},
+		{retry: 2, want: 200 * time.Millisecond},
+		{retry: 3, want: 400 * time.Millisecond},
+		{retry: 4, want: 800 * time.Millisecond},
+		{retry: 5, want: time.Second},
+		{retry: 50, want: time.Second},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			got := p.backoff(tt.retry)
+			if got < tt.want/2 || got > tt.want {
+				t.Fatalf("backoff(%d) = %v, want between %v and %v", tt.retry, got, tt.want/2, tt.want)
+			}
+		}
+	}
+}
+
+func TestRetryPolicy_backoff_Unbounded(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: 500 * time.Millisecond}
+
+	prev := time.Duration(0)
+	for _, retry := range []int{1, 10, 35, 36, 37, 64, 100, 1000} {
+		got := p.backoff(retry)
+		if got <= 0 {
+			t.Fatalf("backoff(%d) without MaxBackoff = %v, want a positive delay", retry, got)
+		}
+		if got < prev/2 {
+			t.Errorf("backoff(%d) = %v, want at least %v", retry, got, prev/2)
+		}
+		prev = got
+	}
+}
+
+func TestRetryPolicy_backoff_Jitter(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: time.Second, MaxBackoff: time.Second}
+
+	seen := map[time.Duration]bool{}
+	for i := 0; i < 100; i++ {
+		seen[p.backoff(1)] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("backoff returned the same value 100 times, want jitter")
+	}
+}
+
+func TestRetryPolicy_backoff_Zero(t *testing.T) {
+	p := &RetryPolicy{}
+	if got := p.backoff(3); got != 0 {
+		t.Errorf("backoff without MinBackoff = %v, want 0", got)
+	}
+}
+
+func TestRetryPolicy_shouldRetry(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errAPI := errors.New("api error")
+	response := func(status int) *Response {
+		return &Response{Response: &http.Response{StatusCode: status}}
+	}
+
+	tests := []struct {
+		name   string
+		policy *RetryPolicy
+		ctx    context.Context
+		resp   *Response
+		err    error
+		want   bool
+	}{
+		{name: "success", policy: &RetryPolicy{}, ctx: context.Background(), resp: response(200), want: false},
+		{name: "network error", policy: &RetryPolicy{}, ctx: context.Background(), err: errAPI, want: true},
+		{name: "canceled context", policy: &RetryPolicy{}, ctx: canceled, err: errAPI, want: false},
+		{name: "rate limited", policy: &RetryPolicy{}, ctx: context.Background(), resp: response(429), err: errAPI, want: true},
+		{name: "service unavailable", policy: &RetryPolicy{}, ctx: context.Background(), resp: response(503), err: errAPI, want: true},
+		{name: "not implemented", policy: &RetryPolicy{}, ctx: context.Background(), resp: response(501), err: errAPI, want: false},
+		{name: "bad request", policy: &RetryPolicy{}, ctx: context.Background(), resp: response(400), err: errAPI, want: false},
+		{name: "custom status code", policy: &RetryPolicy{RetryableStatusCodes: []int{409}}, ctx: context.Background(), resp: response(409), err: errAPI, want: true},
+		{name: "custom status codes replace defaults", policy: &RetryPolicy{RetryableStatusCodes: []int{409}}, ctx: context.Background(), resp: response(503), err: errAPI, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.shouldRetry(tt.ctx, tt.resp, tt.err); got != tt.want {
+				t.Errorf("shouldRetry = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_retryPolicyFor(t *testing.T) {
+	c := NewClient("key", nil)
+	c.SetRetryPolicy(DefaultRetryPolicy())
+
+	tests := []struct {
+		method    string
+		retryable bool
+		want      bool
+	}{
+		{method: http.MethodGet, want: true},
+		{method: http.MethodHead, want: true},
+		{method: http.MethodOptions, want: true},
+		{method: http.MethodTrace, want: true},
+		{method: http.MethodPut, want: true},
+		{method: http.MethodDelete, want: true},
+		{method: http.MethodPost, want: false},
+		{method: http.MethodPatch, want: false},
+		{method: http.MethodPost, retryable: true, want: true},
+	}
+	for _, tt := range tests {
+		req, err := c.NewRequest(tt.method, "incidents", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := c.retryPolicyFor(req, tt.retryable) != nil; got != tt.want {
+			t.Errorf("retryPolicyFor(%s, %v) retries = %v, want %v", tt.method, tt.retryable, got, tt.want)
+		}
+	}
+
+	c.SetRetryPolicy(nil)
+	req, _ := c.NewRequest(http.MethodGet, "incidents", nil)
+	if c.retryPolicyFor(req, false) != nil {
+		t.Errorf("retryPolicyFor without policy returned a policy")
+	}
+}
+
+// retryServer responds with the given status codes in order,
+// and records the bodies and headers of all requests.
+type retryServer struct {
+	mu       sync.Mutex
+	statuses []int
+	bodies   []string
+	headers  []http.Header
+}
+
+func (s *retryServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	s.bodies = append(s.bodies, string(body))
+	s.headers = append(s.headers, r.Header.Clone())
+
+	status := http.StatusOK
+	if n := len(s.bodies) - 1; n < len(s.statuses) {
+		status = s.statuses[n]
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	io.WriteString(w, `{}`)
+}
+
+func newRetryTestClient(t *testing.T, s *retryServer) *Client {
+	t.Helper()
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+	c.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	return c
+}
+
+func TestClient_BareDo_RetryRewindsBody(t *testing.T) {
+	s := &retryServer{statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway}}
+	c := newRetryTestClient(t, s)
+
+	req, err := c.NewRequest(http.MethodPost, "incidents", map[string]string{"name": "Database is down"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Do(context.Background(), MarkRetryable(req), nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	if len(s.bodies) != 3 {
+		t.Fatalf("server received %d requests, want 3", len(s.bodies))
+	}
+	for i, b := range s.bodies {
+		if b != s.bodies[0] || b == "" {
+			t.Errorf("body of attempt %d = %q, want %q", i+1, b, s.bodies[0])
+		}
+	}
+	for i, h := range s.headers {
+		if v := h.Get("Idempotency-Key"); v != "" {
+			t.Errorf("attempt %d sent Idempotency-Key header %q, want none", i+1, v)
+		}
+	}
+}
+
+func TestClient_BareDo_NoRetryForNonIdempotentRequest(t *testing.T) {
+	s := &retryServer{statuses: []int{http.StatusServiceUnavailable}}
+	c := newRetryTestClient(t, s)
+
+	req, err := c.NewRequest(http.MethodPost, "incidents", map[string]string{"name": "Database is down"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.Do(context.Background(), req, nil)
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Do returned error %v, want 503 error response", err)
+	}
+	if len(s.bodies) != 1 {
+		t.Errorf("server received %d requests, want 1", len(s.bodies))
+	}
+}
+
+func TestClient_BareDo_GivesUpAfterMaxAttempts(t *testing.T) {
+	s := &retryServer{statuses: []int{500, 500, 500, 500}}
+	c := newRetryTestClient(t, s)
+
+	req, err := c.NewRequest(http.MethodGet, "incidents", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Do(context.Background(), req, nil); err == nil {
+		t.Errorf("Do returned no error, want the error of the last attempt")
+	}
+	if len(s.bodies) != 3 {
+		t.Errorf("server received %d requests, want 3", len(s.bodies))
+	}
+}
+
+func TestClient_BareDo_CanceledDuringBackoff(t *testing.T) {
+	s := &retryServer{statuses: []int{http.StatusServiceUnavailable}}
+	c := newRetryTestClient(t, s)
+	c.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, MinBackoff: time.Hour})
+
+	req, err := c.NewRequest(http.MethodGet, "incidents", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	resp, err := c.BareDo(ctx, req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("BareDo returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("BareDo returned response %v, want the last response with status 503", resp)
+	}
+}
+
+func TestMarkRetryable(t *testing.T) {
+	s := &retryServer{statuses: []int{http.StatusServiceUnavailable}}
+	c := newRetryTestClient(t, s)
+
+	req, err := c.NewRequest(http.MethodPost, "alert_events/http/src_1", map[string]string{"title": "CPU high"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Do(context.Background(), MarkRetryable(req), nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if len(s.bodies) != 2 {
+		t.Errorf("server received %d requests, want 2", len(s.bodies))
+	}
+}
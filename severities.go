@@ -53,3 +53,61 @@ func (s *SeveritiesService) Get(ctx context.Context, id string) (*SeverityRespon
 
 	return v, resp, nil
 }
+
+// Create creates a new incident severity.
+//
+// API docs: https://api-docs.incident.io/#operation/Severities_Create
+func (s *SeveritiesService) Create(ctx context.Context, opts *CreateSeverityRequest) (*SeverityResponse, *Response, error) {
+	u := "severities"
+
+	req, err := s.client.NewRequest("POST", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &SeverityResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Update updates an existing incident severity.
+//
+// id represents the unique identifier for the severity
+//
+// API docs: https://api-docs.incident.io/#operation/Severities_Update
+func (s *SeveritiesService) Update(ctx context.Context, id string, opts *UpdateSeverityRequest) (*SeverityResponse, *Response, error) {
+	u := fmt.Sprintf("severities/%s", id)
+
+	req, err := s.client.NewRequest("PUT", u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &SeverityResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Delete deletes an incident severity.
+//
+// id represents the unique identifier for the severity
+//
+// API docs: https://api-docs.incident.io/#operation/Severities_Delete
+func (s *SeveritiesService) Delete(ctx context.Context, id string) (*Response, error) {
+	u := fmt.Sprintf("severities/%s", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,131 @@
+package incident
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// recordedRequest is a request recorded by a test server,
+// e.g. of newSeveritiesTestClient.
+type recordedRequest struct {
+	method string
+	path   string
+	body   map[string]interface{}
+}
+
+// newSeveritiesTestClient returns a client for a server that records
+// every request and responds with an empty severity.
+func newSeveritiesTestClient(t *testing.T, requests *[]recordedRequest) *Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := recordedRequest{method: r.Method, path: r.URL.Path}
+		if r.Method != http.MethodDelete {
+			json.NewDecoder(r.Body).Decode(&req.body)
+		}
+		*requests = append(*requests, req)
+
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"severity":{"id":"sev_1"}}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+	return c
+}
+
+func TestSeveritiesService_Rank(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		call       func(c *Client) error
+		wantMethod string
+		wantPath   string
+		wantBody   map[string]interface{}
+	}{
+		{
+			name: "create without rank",
+			call: func(c *Client) error {
+				_, _, err := c.Severities.Create(ctx, &CreateSeverityRequest{Name: "Minor", Description: "Low impact"})
+				return err
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/v1/severities",
+			wantBody:   map[string]interface{}{"name": "Minor", "description": "Low impact"},
+		},
+		{
+			name: "create with rank 0",
+			call: func(c *Client) error {
+				_, _, err := c.Severities.Create(ctx, &CreateSeverityRequest{Name: "Minor", Rank: Int64(0)})
+				return err
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/v1/severities",
+			wantBody:   map[string]interface{}{"name": "Minor", "description": "", "rank": float64(0)},
+		},
+		{
+			name: "update without rank",
+			call: func(c *Client) error {
+				_, _, err := c.Severities.Update(ctx, "sev_1", &UpdateSeverityRequest{Name: "Major"})
+				return err
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/v1/severities/sev_1",
+			wantBody:   map[string]interface{}{"name": "Major", "description": ""},
+		},
+		{
+			name: "update with rank",
+			call: func(c *Client) error {
+				_, _, err := c.Severities.Update(ctx, "sev_1", &UpdateSeverityRequest{Name: "Major", Rank: Int64(3)})
+				return err
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/v1/severities/sev_1",
+			wantBody:   map[string]interface{}{"name": "Major", "description": "", "rank": float64(3)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []recordedRequest
+			c := newSeveritiesTestClient(t, &requests)
+
+			if err := tt.call(c); err != nil {
+				t.Fatalf("returned error: %v", err)
+			}
+			if len(requests) != 1 {
+				t.Fatalf("sent %d requests, want 1", len(requests))
+			}
+			if requests[0].method != tt.wantMethod || requests[0].path != tt.wantPath {
+				t.Errorf("sent %s %s, want %s %s", requests[0].method, requests[0].path, tt.wantMethod, tt.wantPath)
+			}
+			if !reflect.DeepEqual(requests[0].body, tt.wantBody) {
+				t.Errorf("sent body %v, want %v", requests[0].body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestSeveritiesService_Delete(t *testing.T) {
+	var requests []recordedRequest
+	c := newSeveritiesTestClient(t, &requests)
+
+	if _, err := c.Severities.Delete(context.Background(), "sev_1"); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+
+	want := []recordedRequest{{method: http.MethodDelete, path: "/v1/severities/sev_1"}}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("Delete sent %+v, want %+v", requests, want)
+	}
+}
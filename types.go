@@ -1,6 +1,7 @@
 package incident
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -30,6 +31,16 @@ const (
 	IncidentStatusMonitoring    = "monitoring"
 	IncidentStatusTriage        = "triage"
 
+	// Incident Status Category
+	IncidentStatusCategoryTriage   = "triage"
+	IncidentStatusCategoryDeclined = "declined"
+	IncidentStatusCategoryMerged   = "merged"
+	IncidentStatusCategoryCanceled = "canceled"
+	IncidentStatusCategoryLive     = "live"     // The incident is active
+	IncidentStatusCategoryLearning = "learning" // The incident is in its post-incident phase
+	IncidentStatusCategoryClosed   = "closed"
+	IncidentStatusCategoryPaused   = "paused"
+
 	// Incident Type
 	IncidentTypeReal     = "real"
 	IncidentTypeTest     = "test"
@@ -46,11 +57,36 @@ const (
 	ExternalIssueReferenceProviderJiraServer = "jira_server"
 	ExternalIssueReferenceProviderLinear     = "linear"
 
+	// Incident Attachment Resource Type
+	IncidentAttachmentResourceTypeAtlassianStatuspageIncident = "atlassian_statuspage_incident"
+	IncidentAttachmentResourceTypeDatadogMonitorAlert         = "datadog_monitor_alert"
+	IncidentAttachmentResourceTypeGithubPullRequest           = "github_pull_request"
+	IncidentAttachmentResourceTypeGitlabMergeRequest          = "gitlab_merge_request"
+	IncidentAttachmentResourceTypeOpsgenieAlert               = "opsgenie_alert"
+	IncidentAttachmentResourceTypePagerDutyIncident           = "pager_duty_incident"
+	IncidentAttachmentResourceTypeSentryIssue                 = "sentry_issue"
+	IncidentAttachmentResourceTypeStatuspageIncident          = "statuspage_incident"
+	IncidentAttachmentResourceTypeZendeskTicket               = "zendesk_ticket"
+
 	// Action Status
 	ActionStatusCompleted   = "completed"
 	ActionStatusDeleted     = "deleted"
 	ActionStatusNotDoing    = "not_doing"
 	ActionStatusOutstanding = "outstanding"
+
+	// Follow-up Status
+	FollowUpStatusCompleted   = "completed"
+	FollowUpStatusDeleted     = "deleted"
+	FollowUpStatusNotDoing    = "not_doing"
+	FollowUpStatusOutstanding = "outstanding"
+
+	// Alert Event Status
+	AlertEventStatusFiring   = "firing"
+	AlertEventStatusResolved = "resolved"
+
+	// Alert Status
+	AlertStatusFiring   = "firing"
+	AlertStatusResolved = "resolved"
 )
 
 // IncidentsListOptions defines parameters for IncidentsService.List.
@@ -101,6 +137,11 @@ type Incident struct {
 	// Current status of the incident
 	Status string `json:"status"`
 
+	// Current status of the incident, including its category.
+	// Only set by endpoints of version 2 of the API,
+	// see IncidentStatusesService.ForIncident to resolve Status.
+	IncidentStatus *IncidentStatus `json:"incident_status,omitempty"`
+
 	// Detailed description of the incident
 	Summary string `json:"summary,omitempty"`
 
@@ -110,6 +151,9 @@ type Incident struct {
 	// Whether the incident is real, a test, or a tutorial
 	Type string `json:"type"`
 
+	// Incident type as configured by the organisation, if set
+	IncidentType *IncidentType `json:"incident_type,omitempty"`
+
 	// When the incident was last updated
 	UpdatedAt time.Time `json:"updated_at"`
 
@@ -117,6 +161,149 @@ type Incident struct {
 	Visibility string `json:"visibility"`
 }
 
+// CreateIncidentRequest defines the payload for IncidentsService.Create.
+type CreateIncidentRequest struct {
+	// Unique string used to de-duplicate incident create requests.
+	// Retrying a request with the same key will not create a second incident.
+	IdempotencyKey string `json:"idempotency_key"`
+
+	// Explanation of the incident
+	Name string `json:"name,omitempty"`
+
+	// Detailed description of the incident
+	Summary string `json:"summary,omitempty"`
+
+	// Severity to create incident as
+	SeverityID string `json:"severity_id,omitempty"`
+
+	// Whether the incident is real, a test, or a tutorial
+	// Enum: "real" "test" "tutorial"
+	Mode string `json:"mode,omitempty"`
+
+	// Whether the incident should be open to anyone in your Slack workspace (public), or invite-only (private)
+	// Enum: "public" "private"
+	Visibility string `json:"visibility,omitempty"`
+
+	// Incident type to create this incident as
+	IncidentTypeID string `json:"incident_type_id,omitempty"`
+
+	// Set the incident's custom fields to these values
+	CustomFieldEntries []CustomFieldEntryPayload `json:"custom_field_entries,omitempty"`
+
+	// Assign incident roles to these people
+	IncidentRoleAssignments []IncidentRoleAssignmentPayload `json:"incident_role_assignments,omitempty"`
+}
+
+// EditIncidentRequest defines the payload for IncidentsService.Edit.
+type EditIncidentRequest struct {
+	// Fields of the incident that should be changed
+	Incident EditIncidentFields `json:"incident"`
+
+	// Should we send Slack channel notifications to inform responders of this update?
+	NotifyIncidentChannel bool `json:"notify_incident_channel"`
+}
+
+// EditIncidentFields contains the fields of an incident that can be edited.
+//
+// A nil field is not sent to the API and leaves the incident untouched.
+// To clear a field, set it to an empty value, e.g. incident.String("").
+// A custom field is cleared by passing an entry without values.
+type EditIncidentFields struct {
+	// Explanation of the incident
+	Name *string `json:"name,omitempty"`
+
+	// Detailed description of the incident
+	Summary *string `json:"summary,omitempty"`
+
+	// Severity to change incident to
+	SeverityID *string `json:"severity_id,omitempty"`
+
+	// Incident status to change incident to
+	IncidentStatusID *string `json:"incident_status_id,omitempty"`
+
+	// Set the incident's custom fields to these values.
+	// Custom fields not listed are left untouched.
+	CustomFieldEntries []CustomFieldEntryPayload `json:"custom_field_entries,omitempty"`
+
+	// Assign incident roles to these people.
+	// An assignment without assignee unassigns the role.
+	// Roles not listed are left untouched.
+	IncidentRoleAssignments []IncidentRoleAssignmentPayload `json:"incident_role_assignments,omitempty"`
+}
+
+// AssignIncidentRolesRequest defines the payload for IncidentsService.AssignRoles.
+type AssignIncidentRolesRequest struct {
+	// Roles to assign or unassign.
+	// An assignment without assignee unassigns the role.
+	Assignments []IncidentRoleAssignmentPayload
+
+	// Should we send Slack channel notifications to inform responders of this update?
+	NotifyIncidentChannel bool
+}
+
+// CustomFieldEntryPayload sets the values of a single custom field
+// when creating or editing an incident.
+type CustomFieldEntryPayload struct {
+	// ID of the custom field
+	CustomFieldID string `json:"custom_field_id"`
+
+	// List of values to set on the custom field
+	Values []CustomFieldValuePayload `json:"values"`
+}
+
+// MarshalJSON encodes an entry without values as an empty list,
+// which clears the custom field when editing an incident.
+func (p CustomFieldEntryPayload) MarshalJSON() ([]byte, error) {
+	type alias CustomFieldEntryPayload
+	a := alias(p)
+	if a.Values == nil {
+		a.Values = []CustomFieldValuePayload{}
+	}
+	return json.Marshal(a)
+}
+
+// CustomFieldValuePayload is a single value of a custom field.
+// Only the member matching the field type of the custom field should be set.
+type CustomFieldValuePayload struct {
+	// Link value
+	ValueLink string `json:"value_link,omitempty"`
+
+	// Numeric value
+	ValueNumeric string `json:"value_numeric,omitempty"`
+
+	// ID of the custom field option
+	ValueOptionID string `json:"value_option_id,omitempty"`
+
+	// Text value
+	ValueText string `json:"value_text,omitempty"`
+
+	// ID of the catalog entry
+	ValueCatalogEntryID string `json:"value_catalog_entry_id,omitempty"`
+}
+
+// IncidentRoleAssignmentPayload assigns a user to an incident role.
+type IncidentRoleAssignmentPayload struct {
+	// User that should be assigned to the role.
+	// When editing an incident, nil unassigns the role.
+	Assignee *UserReference `json:"assignee,omitempty"`
+
+	// Unique identifier of the incident role
+	IncidentRoleID string `json:"incident_role_id"`
+}
+
+// UserReference identifies a user by one of its identifiers.
+// Only one of the fields needs to be set.
+type UserReference struct {
+	// Unique identifier of the user
+	ID string `json:"id,omitempty"`
+
+	// Email of the user
+	Email string `json:"email,omitempty"`
+
+	// Slack User ID of the user
+	SlackUserID string `json:"slack_user_id,omitempty"`
+}
+
 type IncidentsList struct {
 	Incidents      []Incident      `json:"incidents"`
 	PaginationMeta *PaginationMeta `json:"pagination_meta,omitempty"`
@@ -186,11 +373,15 @@ type CustomFieldValue struct {
 	ValueCatalogEntry *CatalogEntry `json:"value_catalog_entry,omitempty"`
 }
 
-// CatalogEntry represents a catalog entry value for custom fields
+// CatalogEntry represents an entry of the catalog.
+// It is also used as catalog entry value for custom fields.
 type CatalogEntry struct {
 	// Unique identifier for the catalog entry
 	ID string `json:"id"`
 
+	// ID of the catalog type this entry belongs to
+	CatalogTypeID string `json:"catalog_type_id,omitempty"`
+
 	// External identifier from the source system
 	ExternalID string `json:"external_id,omitempty"`
 
@@ -199,6 +390,261 @@ type CatalogEntry struct {
 
 	// Alternative names for the catalog entry
 	Aliases []string `json:"aliases,omitempty"`
+
+	// When the catalog type is ranked, this is used to help order things
+	Rank int32 `json:"rank,omitempty"`
+
+	// Values of this entry, by ID of the schema attribute
+	AttributeValues map[string]CatalogAttributeBinding `json:"attribute_values,omitempty"`
+
+	// When the entry was archived, if it was
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
+	// When the entry was created
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// When the entry was last updated
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// CatalogAttributeBinding is the value of a schema attribute on a catalog entry.
+// Value is set for single value attributes, ArrayValue for array attributes.
+type CatalogAttributeBinding struct {
+	// Value of a single value attribute
+	Value *CatalogAttributeValue `json:"value,omitempty"`
+
+	// Values of an array attribute
+	ArrayValue []CatalogAttributeValue `json:"array_value,omitempty"`
+}
+
+// CatalogAttributeValue is a single value of a schema attribute.
+type CatalogAttributeValue struct {
+	// Human readable label of the value
+	Label string `json:"label,omitempty"`
+
+	// The literal value of this attribute, e.g. the ID of another catalog entry
+	Literal string `json:"literal,omitempty"`
+}
+
+// CatalogAttributeBindingPayload sets the value of a schema attribute
+// when creating or updating a catalog entry.
+type CatalogAttributeBindingPayload struct {
+	// Value of a single value attribute
+	Value *CatalogAttributeValuePayload `json:"value,omitempty"`
+
+	// Values of an array attribute
+	ArrayValue []CatalogAttributeValuePayload `json:"array_value,omitempty"`
+}
+
+// CatalogAttributeValuePayload is a single value of a schema attribute.
+type CatalogAttributeValuePayload struct {
+	// The literal value of this attribute
+	Literal string `json:"literal"`
+}
+
+type CatalogType struct {
+	// Unique identifier for this catalog type
+	ID string `json:"id"`
+
+	// Name is the human readable name of this type
+	Name string `json:"name"`
+
+	// Human readable description of this type
+	Description string `json:"description"`
+
+	// The type name of this catalog type, to be used when defining attributes
+	TypeName string `json:"type_name"`
+
+	// Sets the display color of this type in the dashboard
+	Color string `json:"color,omitempty"`
+
+	// Sets the display icon of this type in the dashboard
+	Icon string `json:"icon,omitempty"`
+
+	// If this type should be ranked
+	Ranked bool `json:"ranked"`
+
+	// If this type can be modified via the API
+	IsEditable bool `json:"is_editable"`
+
+	// Semantic type of this resource
+	SemanticType string `json:"semantic_type,omitempty"`
+
+	// Annotations that can track metadata about this type
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Schema of the attributes of this type
+	Schema CatalogTypeSchema `json:"schema"`
+
+	// When this type was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// When this type was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CatalogTypeSchema struct {
+	// Version of the schema, incremented with every update
+	Version int64 `json:"version"`
+
+	// Attributes of this catalog type
+	Attributes []CatalogTypeAttribute `json:"attributes"`
+}
+
+type CatalogTypeAttribute struct {
+	// Unique identifier for this attribute
+	ID string `json:"id,omitempty"`
+
+	// Unique name of this attribute
+	Name string `json:"name"`
+
+	// Catalog type name for this attribute, e.g. "String" or the type name of another catalog type
+	Type string `json:"type"`
+
+	// Whether this attribute is an array
+	Array bool `json:"array"`
+
+	// Controls how this attribute is modified
+	// Enum: "", "manual", "external", "internal", "dynamic", "backlink", "path"
+	Mode string `json:"mode,omitempty"`
+}
+
+type CatalogTypesList struct {
+	CatalogTypes []CatalogType `json:"catalog_types"`
+}
+
+type CatalogTypeResponse struct {
+	CatalogType CatalogType `json:"catalog_type"`
+}
+
+// CreateCatalogTypeRequest defines the payload for CatalogService.CreateType.
+type CreateCatalogTypeRequest struct {
+	// Name is the human readable name of this type
+	Name string `json:"name"`
+
+	// Human readable description of this type
+	Description string `json:"description"`
+
+	// The type name of this catalog type, to be used when defining attributes.
+	// This is immutable once a catalog type has been created.
+	TypeName string `json:"type_name,omitempty"`
+
+	// Sets the display color of this type in the dashboard
+	Color string `json:"color,omitempty"`
+
+	// Sets the display icon of this type in the dashboard
+	Icon string `json:"icon,omitempty"`
+
+	// If this type should be ranked
+	Ranked bool `json:"ranked,omitempty"`
+
+	// Semantic type of this resource
+	SemanticType string `json:"semantic_type,omitempty"`
+
+	// Annotations that can track metadata about this type
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// UpdateCatalogTypeRequest defines the payload for CatalogService.UpdateType.
+type UpdateCatalogTypeRequest struct {
+	// Name is the human readable name of this type
+	Name string `json:"name"`
+
+	// Human readable description of this type
+	Description string `json:"description"`
+
+	// Sets the display color of this type in the dashboard
+	Color string `json:"color,omitempty"`
+
+	// Sets the display icon of this type in the dashboard
+	Icon string `json:"icon,omitempty"`
+
+	// If this type should be ranked
+	Ranked bool `json:"ranked,omitempty"`
+
+	// Semantic type of this resource
+	SemanticType string `json:"semantic_type,omitempty"`
+
+	// Annotations that can track metadata about this type
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// UpdateCatalogTypeSchemaRequest defines the payload for CatalogService.UpdateTypeSchema.
+type UpdateCatalogTypeSchemaRequest struct {
+	// Version of the schema that is updated, to detect concurrent updates
+	Version int64 `json:"version"`
+
+	// Attributes of the catalog type. Attributes not listed are removed.
+	Attributes []CatalogTypeAttribute `json:"attributes"`
+}
+
+// CatalogEntriesListOptions defines parameters for CatalogService.ListEntries.
+type CatalogEntriesListOptions struct {
+	// ID of the catalog type to list entries for
+	CatalogTypeID string `url:"catalog_type_id"`
+
+	// Number of records to return
+	PageSize int `url:"page_size,omitempty"`
+
+	// A catalog entry's ID. This endpoint will return a list of entries after this entry.
+	After string `url:"after,omitempty"`
+
+	// Filter for entries with this ID, external ID or alias
+	Identifier string `url:"identifier,omitempty"`
+}
+
+type CatalogEntriesList struct {
+	CatalogEntries []CatalogEntry  `json:"catalog_entries"`
+	CatalogType    CatalogType     `json:"catalog_type"`
+	PaginationMeta *PaginationMeta `json:"pagination_meta,omitempty"`
+}
+
+type CatalogEntryResponse struct {
+	CatalogEntry CatalogEntry `json:"catalog_entry"`
+	CatalogType  CatalogType  `json:"catalog_type"`
+}
+
+// CreateCatalogEntryRequest defines the payload for CatalogService.CreateEntry.
+type CreateCatalogEntryRequest struct {
+	// ID of the catalog type this entry belongs to
+	CatalogTypeID string `json:"catalog_type_id"`
+
+	// Human readable name of the catalog entry
+	Name string `json:"name"`
+
+	// External identifier from the source system, to match the entry on later syncs
+	ExternalID string `json:"external_id,omitempty"`
+
+	// Alternative names that can be used to refer to this entry
+	Aliases []string `json:"aliases,omitempty"`
+
+	// When the catalog type is ranked, this is used to help order things
+	Rank int32 `json:"rank,omitempty"`
+
+	// Values of this entry, by ID of the schema attribute
+	AttributeValues map[string]CatalogAttributeBindingPayload `json:"attribute_values"`
+}
+
+// UpdateCatalogEntryRequest defines the payload for CatalogService.UpdateEntry.
+type UpdateCatalogEntryRequest struct {
+	// Human readable name of the catalog entry
+	Name string `json:"name"`
+
+	// External identifier from the source system, to match the entry on later syncs
+	ExternalID string `json:"external_id,omitempty"`
+
+	// Alternative names that can be used to refer to this entry
+	Aliases []string `json:"aliases,omitempty"`
+
+	// When the catalog type is ranked, this is used to help order things
+	Rank int32 `json:"rank,omitempty"`
+
+	// Values of this entry, by ID of the schema attribute
+	AttributeValues map[string]CatalogAttributeBindingPayload `json:"attribute_values"`
+
+	// IDs of the attributes to update. If empty, all attributes are updated
+	// and attributes missing in AttributeValues are cleared.
+	UpdateAttributes []string `json:"update_attributes,omitempty"`
 }
 
 type IncidentRoleAssignment struct {
@@ -305,6 +751,68 @@ type SeverityResponse struct {
 	Severity Severity `json:"severity"`
 }
 
+// CreateSeverityRequest defines the payload for SeveritiesService.Create.
+type CreateSeverityRequest struct {
+	// Human readable name of the severity
+	Name string `json:"name"`
+
+	// Description of the severity
+	Description string `json:"description"`
+
+	// Rank to help sort severities (lower numbers are less severe).
+	// If nil, the API assigns the rank.
+	Rank *int64 `json:"rank,omitempty"`
+}
+
+// UpdateSeverityRequest defines the payload for SeveritiesService.Update.
+type UpdateSeverityRequest struct {
+	// Human readable name of the severity
+	Name string `json:"name"`
+
+	// Description of the severity
+	Description string `json:"description"`
+
+	// Rank to help sort severities (lower numbers are less severe).
+	// If nil, the rank of the severity is left untouched.
+	Rank *int64 `json:"rank,omitempty"`
+}
+
+// CreateIncidentRoleRequest defines the payload for IncidentRolesService.Create.
+type CreateIncidentRoleRequest struct {
+	// Human readable name of the incident role
+	Name string `json:"name"`
+
+	// Describes the purpose of the role
+	Description string `json:"description"`
+
+	// Provided to whoever is nominated for the role
+	Instructions string `json:"instructions"`
+
+	// Short human readable name for Slack
+	Shortform string `json:"shortform"`
+
+	// Whether incident require this role to be set
+	Required bool `json:"required"`
+}
+
+// UpdateIncidentRoleRequest defines the payload for IncidentRolesService.Update.
+type UpdateIncidentRoleRequest struct {
+	// Human readable name of the incident role
+	Name string `json:"name"`
+
+	// Describes the purpose of the role
+	Description string `json:"description"`
+
+	// Provided to whoever is nominated for the role
+	Instructions string `json:"instructions"`
+
+	// Short human readable name for Slack
+	Shortform string `json:"shortform"`
+
+	// Whether incident require this role to be set
+	Required bool `json:"required"`
+}
+
 type IncidentRolesList struct {
 	IncidentRoles []IncidentRole `json:"incident_roles"`
 }
@@ -332,6 +840,9 @@ type CustomField struct {
 	// What options are available for this custom field, if this field has options
 	Options []CustomFieldOption `json:"options"`
 
+	// ID of the catalog type backing this custom field, if its values are catalog entries
+	CatalogTypeID string `json:"catalog_type_id,omitempty"`
+
 	// Whether a custom field should be required in the incident close modal
 	RequireBeforeClosure bool `json:"require_before_closure"`
 
@@ -356,35 +867,126 @@ type CustomFieldResponse struct {
 	CustomField CustomField `json:"custom_field"`
 }
 
-type ActionsListOptions struct {
-	// Find actions related to this incident
-	IncidentId string `url:"incident_id,omitempty"`
-
-	// Filter to actions marked as being follow up actions
-	IsFollowUp bool `url:"is_follow_up,omitempty"`
+// CreateCustomFieldRequest defines the payload for CustomFieldsService.Create.
+type CreateCustomFieldRequest struct {
+	// Human readable name for the custom field
+	Name string `json:"name"`
 
-	// Filter to actions from incidents of the given mode.
-	// If not set, only actions from real incidents are returned
-	// Enum: "real" "test" "tutorial"
-	IncidentMode string `url:"incident_mode,omitempty"`
-}
+	// Description of the custom field
+	Description string `json:"description"`
 
-type ActionsList struct {
-	Actions []Action `json:"actions"`
-}
+	// Type of custom field
+	// Enum: "link" "multi_select" "numeric" "single_select" "text"
+	FieldType string `json:"field_type"`
 
-type Action struct {
-	// When the action was completed
-	CompletedAt time.Time `json:"completed_at,omitempty"`
+	// Whether a custom field should be required in the incident close modal
+	RequireBeforeClosure bool `json:"require_before_closure"`
 
-	// When the action was created
-	CreatedAt time.Time `json:"created_at"`
+	// Whether a custom field should be required in the incident creation modal
+	RequireBeforeCreation bool `json:"require_before_creation"`
 
-	// Description of the action
-	Description            string                  `json:"description"`
-	ExternalIssueReference *ExternalIssueReference `json:"external_issue_reference,omitempty"`
+	// Whether a custom field should be shown in the incident close modal
+	ShowBeforeClosure bool `json:"show_before_closure"`
 
-	// Whether an action is marked as follow-up
+	// Whether a custom field should be shown in the incident creation modal
+	ShowBeforeCreation bool `json:"show_before_creation"`
+}
+
+// UpdateCustomFieldRequest defines the payload for CustomFieldsService.Update.
+type UpdateCustomFieldRequest struct {
+	// Human readable name for the custom field
+	Name string `json:"name"`
+
+	// Description of the custom field
+	Description string `json:"description"`
+
+	// Whether a custom field should be required in the incident close modal
+	RequireBeforeClosure bool `json:"require_before_closure"`
+
+	// Whether a custom field should be required in the incident creation modal
+	RequireBeforeCreation bool `json:"require_before_creation"`
+
+	// Whether a custom field should be shown in the incident close modal
+	ShowBeforeClosure bool `json:"show_before_closure"`
+
+	// Whether a custom field should be shown in the incident creation modal
+	ShowBeforeCreation bool `json:"show_before_creation"`
+}
+
+// CustomFieldOptionsListOptions defines parameters for CustomFieldOptionsService.List.
+type CustomFieldOptionsListOptions struct {
+	// The custom field to list options for
+	CustomFieldID string `url:"custom_field_id"`
+
+	// Number of records to return
+	PageSize int `url:"page_size,omitempty"`
+
+	// A custom field option's ID. This endpoint will return a list of options after this option.
+	After string `url:"after,omitempty"`
+}
+
+type CustomFieldOptionsList struct {
+	CustomFieldOptions []CustomFieldOption `json:"custom_field_options"`
+	PaginationMeta     *PaginationMeta     `json:"pagination_meta,omitempty"`
+}
+
+type CustomFieldOptionResponse struct {
+	CustomFieldOption CustomFieldOption `json:"custom_field_option"`
+}
+
+// CreateCustomFieldOptionRequest defines the payload for CustomFieldOptionsService.Create.
+type CreateCustomFieldOptionRequest struct {
+	// ID of the custom field this option belongs to
+	CustomFieldID string `json:"custom_field_id"`
+
+	// Human readable name for the custom field option
+	Value string `json:"value"`
+
+	// Sort key used to order the custom field options correctly.
+	// If nil, the API assigns the sort key.
+	SortKey *int64 `json:"sort_key,omitempty"`
+}
+
+// UpdateCustomFieldOptionRequest defines the payload for CustomFieldOptionsService.Update.
+type UpdateCustomFieldOptionRequest struct {
+	// Human readable name for the custom field option
+	Value string `json:"value"`
+
+	// Sort key used to order the custom field options correctly.
+	// If nil, the sort key is left untouched.
+	SortKey *int64 `json:"sort_key,omitempty"`
+}
+
+type ActionsListOptions struct {
+	// Find actions related to this incident
+	IncidentId string `url:"incident_id,omitempty"`
+
+	// Filter to actions marked as being follow up actions.
+	// See FollowUpsService for follow-ups including their priority.
+	IsFollowUp bool `url:"is_follow_up,omitempty"`
+
+	// Filter to actions from incidents of the given mode.
+	// If not set, only actions from real incidents are returned
+	// Enum: "real" "test" "tutorial"
+	IncidentMode string `url:"incident_mode,omitempty"`
+}
+
+type ActionsList struct {
+	Actions []Action `json:"actions"`
+}
+
+type Action struct {
+	// When the action was completed
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+
+	// When the action was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// Description of the action
+	Description            string                  `json:"description"`
+	ExternalIssueReference *ExternalIssueReference `json:"external_issue_reference,omitempty"`
+
+	// Whether an action is marked as follow-up
 	FollowUp bool `json:"follow_up"`
 
 	// Unique identifier for the action
@@ -417,3 +1019,481 @@ type ExternalIssueReference struct {
 type ActionResponse struct {
 	Action Action `json:"action"`
 }
+
+// AlertEvent is an event sent to an HTTP alert source
+// via AlertEventsService.Create.
+type AlertEvent struct {
+	// Alert title which is used when summarising the alert
+	Title string `json:"title"`
+
+	// Description that optionally adds more detail to title. Supports Markdown.
+	Description string `json:"description,omitempty"`
+
+	// Current status of this alert
+	// Enum: "firing" "resolved"
+	Status string `json:"status"`
+
+	// A deduplication key which uniquely references this alert from your alert source.
+	// Events with the same key refer to the same alert.
+	DeduplicationKey string `json:"deduplication_key,omitempty"`
+
+	// Any additional metadata that you've configured your alert source to parse
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+
+	// If applicable, a link to the alert in the upstream system
+	SourceURL string `json:"source_url,omitempty"`
+}
+
+type AlertEventResponse struct {
+	// The deduplication key the event was processed with
+	DeduplicationKey string `json:"deduplication_key"`
+
+	// Human readable message giving detail about the event
+	Message string `json:"message"`
+
+	// Status of the event
+	Status string `json:"status"`
+}
+
+// AlertsListOptions defines parameters for AlertsService.List.
+type AlertsListOptions struct {
+	// Number of records to return
+	PageSize int `url:"page_size,omitempty"`
+
+	// An alert's ID. This endpoint will return a list of alerts after this alert.
+	After string `url:"after,omitempty"`
+
+	// Filter for alerts in these statuses
+	// Enum: "firing" "resolved"
+	Status []string `url:"status[one_of],omitempty"`
+
+	// Filter for alerts of these alert sources
+	AlertSourceID []string `url:"alert_source_id[one_of],omitempty"`
+
+	// Filter for alerts with this deduplication key
+	DeduplicationKey string `url:"deduplication_key[is],omitempty"`
+
+	// Filter for alerts created at or after this time
+	CreatedAfter time.Time `url:"created_at[gte],omitempty"`
+
+	// Filter for alerts created at or before this time
+	CreatedBefore time.Time `url:"created_at[lte],omitempty"`
+}
+
+type Alert struct {
+	// Unique identifier for the alert
+	ID string `json:"id"`
+
+	// ID of the alert source that created this alert
+	AlertSourceID string `json:"alert_source_id"`
+
+	// Title of the alert
+	Title string `json:"title"`
+
+	// Description of the alert
+	Description string `json:"description,omitempty"`
+
+	// Status of the alert
+	// Enum: "firing" "resolved"
+	Status string `json:"status"`
+
+	// Deduplication key of the alert, as sent by the alert source
+	DeduplicationKey string `json:"deduplication_key,omitempty"`
+
+	// Link to the alert in the upstream system
+	SourceURL string `json:"source_url,omitempty"`
+
+	// When the alert was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// When the alert was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// When the alert was resolved, if it was
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+}
+
+type AlertsList struct {
+	Alerts         []Alert         `json:"alerts"`
+	PaginationMeta *PaginationMeta `json:"pagination_meta,omitempty"`
+}
+
+type AlertResponse struct {
+	Alert Alert `json:"alert"`
+}
+
+// IncidentAlertsListOptions defines parameters for AlertsService.ListIncidentAlerts.
+type IncidentAlertsListOptions struct {
+	// Number of records to return
+	PageSize int `url:"page_size,omitempty"`
+
+	// An incident alert's ID. This endpoint will return a list of incident alerts after this one.
+	After string `url:"after,omitempty"`
+
+	// Find incident alerts of this alert
+	AlertID string `url:"alert_id,omitempty"`
+
+	// Find incident alerts of this incident
+	IncidentID string `url:"incident_id,omitempty"`
+}
+
+// IncidentAlert connects an alert with an incident it was attached to.
+type IncidentAlert struct {
+	// Unique identifier for the connection
+	ID string `json:"id"`
+
+	// The alert attached to the incident
+	Alert Alert `json:"alert"`
+
+	// The incident the alert is attached to
+	Incident IncidentAlertIncident `json:"incident"`
+}
+
+// IncidentAlertIncident is the incident an alert is attached to.
+type IncidentAlertIncident struct {
+	// Unique identifier for the incident
+	ID string `json:"id"`
+
+	// Explanation of the incident
+	Name string `json:"name"`
+
+	// Reference to this incident, as displayed across the product
+	Reference string `json:"reference"`
+
+	// Detailed description of the incident
+	Summary string `json:"summary,omitempty"`
+
+	// Whether the incident is public or private
+	Visibility string `json:"visibility"`
+}
+
+type IncidentAlertsList struct {
+	IncidentAlerts []IncidentAlert `json:"incident_alerts"`
+	PaginationMeta *PaginationMeta `json:"pagination_meta,omitempty"`
+}
+
+type AlertSource struct {
+	// Unique identifier for the alert source
+	ID string `json:"id"`
+
+	// Human readable name of the alert source
+	Name string `json:"name"`
+
+	// Type of the alert source, e.g. "http", "datadog" or "prometheus"
+	SourceType string `json:"source_type"`
+}
+
+type AlertSourcesList struct {
+	AlertSources []AlertSource `json:"alert_sources"`
+}
+
+type AlertSourceResponse struct {
+	AlertSource AlertSource `json:"alert_source"`
+}
+
+// IncidentUpdatesListOptions defines parameters for IncidentUpdatesService.List.
+type IncidentUpdatesListOptions struct {
+	// Incident whose updates you want to list
+	IncidentID string `url:"incident_id,omitempty"`
+
+	// Number of records to return
+	PageSize int `url:"page_size,omitempty"`
+
+	// An incident update's ID. This endpoint will return a list of incident updates after this update.
+	After string `url:"after,omitempty"`
+}
+
+// IncidentUpdate is an entry of the incident timeline.
+//
+// The API only reports the status an incident moved to, see NewIncidentStatus.
+// PreviousIncidentStatus is not part of the API response: use
+// SetPreviousIncidentStatuses to fill it from the previous update of the same incident.
+type IncidentUpdate struct {
+	// Unique identifier for this incident update
+	ID string `json:"id"`
+
+	// The incident this update relates to
+	IncidentID string `json:"incident_id"`
+
+	// Message that explains the context behind the update
+	Message string `json:"message,omitempty"`
+
+	// The status the incident moved to with this update
+	NewIncidentStatus IncidentStatus `json:"new_incident_status"`
+
+	// The status the incident was in before this update.
+	// It is nil unless set by SetPreviousIncidentStatuses.
+	PreviousIncidentStatus *IncidentStatus `json:"-"`
+
+	// The severity the incident moved to with this update, if it changed
+	NewSeverity *Severity `json:"new_severity,omitempty"`
+
+	// Who made the update
+	Updater Actor `json:"updater"`
+
+	// When the update was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// The incident this incident was merged into, if this update merged it
+	MergedIntoIncidentID string `json:"merged_into_incident_id,omitempty"`
+}
+
+type IncidentUpdatesList struct {
+	IncidentUpdates []IncidentUpdate `json:"incident_updates"`
+	PaginationMeta  *PaginationMeta  `json:"pagination_meta,omitempty"`
+}
+
+// IncidentStatus is a status of the incident lifecycle, as configured
+// by the organisation.
+type IncidentStatus struct {
+	// Unique ID of this incident status
+	ID string `json:"id"`
+
+	// Unique name of this status
+	Name string `json:"name"`
+
+	// Rich text description of the incident status
+	Description string `json:"description"`
+
+	// Category of the status in the incident lifecycle
+	// Enum: "triage" "declined" "merged" "canceled" "live" "learning" "closed" "paused"
+	Category string `json:"category"`
+
+	// Order of this incident status
+	Rank int64 `json:"rank"`
+
+	// When the status was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// When the status was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IncidentAttachmentsListOptions defines parameters for IncidentAttachmentsService.List.
+type IncidentAttachmentsListOptions struct {
+	// Incident that this attachment is against
+	IncidentID string `url:"incident_id,omitempty"`
+
+	// ID of the resource in the external system
+	ExternalID string `url:"external_id,omitempty"`
+
+	// E.g. PagerDuty: the external system that holds the resource
+	ResourceType string `url:"resource_type,omitempty"`
+}
+
+type IncidentAttachment struct {
+	// Unique identifier of this incident membership
+	ID string `json:"id"`
+
+	// ID of the incident
+	IncidentID string `json:"incident_id"`
+
+	// The external resource that is attached
+	Resource ExternalResource `json:"resource"`
+}
+
+type ExternalResource struct {
+	// ID of the resource in the external system
+	ExternalID string `json:"external_id"`
+
+	// URL of the resource
+	Permalink string `json:"permalink"`
+
+	// E.g. PagerDuty: the external system that holds the resource
+	ResourceType string `json:"resource_type"`
+
+	// Title of resource
+	Title string `json:"title"`
+}
+
+type IncidentAttachmentsList struct {
+	IncidentAttachments []IncidentAttachment `json:"incident_attachments"`
+}
+
+type IncidentAttachmentResponse struct {
+	IncidentAttachment IncidentAttachment `json:"incident_attachment"`
+}
+
+// CreateIncidentAttachmentRequest defines the payload for IncidentAttachmentsService.Create.
+type CreateIncidentAttachmentRequest struct {
+	// ID of the incident to add an attachment to
+	IncidentID string `json:"incident_id"`
+
+	// The external resource to attach
+	Resource ExternalResourceReference `json:"resource"`
+}
+
+// ExternalResourceReference identifies a resource in an external system.
+type ExternalResourceReference struct {
+	// ID of the resource in the external system
+	ExternalID string `json:"external_id"`
+
+	// E.g. PagerDuty: the external system that holds the resource
+	ResourceType string `json:"resource_type"`
+}
+
+// UsersListOptions defines parameters for UsersService.List.
+type UsersListOptions struct {
+	// Number of records to return
+	PageSize int `url:"page_size,omitempty"`
+
+	// A user's ID. This endpoint will return a list of users after this user.
+	After string `url:"after,omitempty"`
+
+	// Filter by email address
+	Email string `url:"email,omitempty"`
+
+	// Filter by Slack user ID
+	SlackUserID string `url:"slack_user_id,omitempty"`
+}
+
+type UsersList struct {
+	Users          []User          `json:"users"`
+	PaginationMeta *PaginationMeta `json:"pagination_meta,omitempty"`
+}
+
+type UserResponse struct {
+	User User `json:"user"`
+}
+
+type IncidentStatusesList struct {
+	IncidentStatuses []IncidentStatus `json:"incident_statuses"`
+}
+
+type IncidentStatusResponse struct {
+	IncidentStatus IncidentStatus `json:"incident_status"`
+}
+
+// CreateIncidentStatusRequest defines the payload for IncidentStatusesService.Create.
+type CreateIncidentStatusRequest struct {
+	// Unique name of this status
+	Name string `json:"name"`
+
+	// Rich text description of the incident status
+	Description string `json:"description"`
+
+	// Category of the status in the incident lifecycle
+	// Enum: "live" "learning" "closed"
+	Category string `json:"category"`
+}
+
+// UpdateIncidentStatusRequest defines the payload for IncidentStatusesService.Update.
+type UpdateIncidentStatusRequest struct {
+	// Unique name of this status
+	Name string `json:"name"`
+
+	// Rich text description of the incident status
+	Description string `json:"description"`
+}
+
+type IncidentType struct {
+	// Unique identifier for this incident type
+	ID string `json:"id"`
+
+	// The name of this incident type
+	Name string `json:"name"`
+
+	// What is this incident type for?
+	Description string `json:"description"`
+
+	// The default incident type is used when no other type is explicitly specified
+	IsDefault bool `json:"is_default"`
+
+	// Should all incidents created with this type be private?
+	PrivateIncidentsOnly bool `json:"private_incidents_only"`
+
+	// Whether incidents of this type must always, or can optionally, be created in triage
+	// Enum: "always" "optional"
+	CreateInTriage string `json:"create_in_triage"`
+
+	// When the incident type was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// When the incident type was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type IncidentTypesList struct {
+	IncidentTypes []IncidentType `json:"incident_types"`
+}
+
+type IncidentTypeResponse struct {
+	IncidentType IncidentType `json:"incident_type"`
+}
+
+// FollowUpsListOptions defines parameters for FollowUpsService.List.
+type FollowUpsListOptions struct {
+	// Find follow-ups related to this incident
+	IncidentID string `url:"incident_id,omitempty"`
+
+	// Filter to follow-ups from incidents of the given mode.
+	// If not set, only follow-ups from real incidents are returned
+	// Enum: "real" "test" "tutorial"
+	IncidentMode string `url:"incident_mode,omitempty"`
+
+	// Filter to follow-ups in these statuses.
+	// Applied by the client, as the API does not support it.
+	Status []string `url:"-"`
+
+	// Filter to follow-ups assigned to this user.
+	// Applied by the client, as the API does not support it.
+	AssigneeID string `url:"-"`
+}
+
+type FollowUp struct {
+	// Unique identifier for the follow-up
+	ID string `json:"id"`
+
+	// Unique identifier of the incident the follow-up belongs to
+	IncidentID string `json:"incident_id"`
+
+	// Title of the follow-up
+	Title string `json:"title"`
+
+	// Description of the follow-up
+	Description string `json:"description,omitempty"`
+
+	// Status of the follow-up
+	// Enum: "outstanding" "completed" "deleted" "not_doing"
+	Status string `json:"status"`
+
+	// Priority of the follow-up, if set
+	Priority *FollowUpPriority `json:"priority,omitempty"`
+
+	// Assignee of the follow-up
+	Assignee *User `json:"assignee,omitempty"`
+
+	// Issue tracker issue the follow-up was exported to, if any
+	ExternalIssueReference *ExternalIssueReference `json:"external_issue_reference,omitempty"`
+
+	// When the follow-up was completed
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// When the follow-up was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// When the follow-up was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type FollowUpPriority struct {
+	// Unique identifier for the follow-up priority option
+	ID string `json:"id"`
+
+	// Name of the follow-up priority option
+	Name string `json:"name"`
+
+	// Description of the follow-up priority option
+	Description string `json:"description,omitempty"`
+
+	// Rank is used to order the follow-up priority options correctly
+	Rank int64 `json:"rank"`
+}
+
+type FollowUpsList struct {
+	FollowUps []FollowUp `json:"follow_ups"`
+}
+
+type FollowUpResponse struct {
+	FollowUp FollowUp `json:"follow_up"`
+}
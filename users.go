@@ -0,0 +1,175 @@
+package incident
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUserNotFound is returned by UsersService.GetByEmail and
+// UsersService.GetBySlackUserID if no user matches.
+var ErrUserNotFound = errors.New("user not found")
+
+// UsersService handles communication with the user related
+// methods of the Incident.io API.
+//
+// API docs: https://api-docs.incident.io/tag/Users-V2
+type UsersService service
+
+// List list users for an organisation.
+//
+// API docs: https://api-docs.incident.io/tag/Users-V2#operation/Users%20V2_List
+func (s *UsersService) List(ctx context.Context, opts *UsersListOptions) (*UsersList, *Response, error) {
+	u := apiV2Path("users")
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &UsersList{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// Iter returns an iterator over all users matching opts.
+// The iterator follows the After cursor of the API and fetches one page
+// at a time. opts is copied and not modified by the iterator.
+func (s *UsersService) Iter(opts *UsersListOptions) *UsersIterator {
+	return NewUsersIterator(s, opts)
+}
+
+// ListAll calls fn for every user matching opts, following all pages.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func (s *UsersService) ListAll(ctx context.Context, opts *UsersListOptions, fn func(User) error) error {
+	return ListAllUsers(ctx, s, opts, fn)
+}
+
+// UsersIterator iterates over the pages of UsersService.List.
+// Create one with UsersService.Iter.
+type UsersIterator struct {
+	pager *cursorPager[User]
+}
+
+// NewUsersIterator returns an iterator over all users matching opts,
+// that fetches the pages with api.List.
+// This is useful to iterate over users of a mock UsersAPI.
+// opts is copied and not modified by the iterator.
+func NewUsersIterator(api UsersAPI, opts *UsersListOptions) *UsersIterator {
+	o := UsersListOptions{}
+	if opts != nil {
+		o = *opts
+	}
+
+	fetch := func(ctx context.Context, after string) ([]User, *PaginationMeta, error) {
+		o.After = after
+		list, _, err := api.List(ctx, &o)
+		if err != nil {
+			return nil, nil, err
+		}
+		return list.Users, list.PaginationMeta, nil
+	}
+	id := func(v User) string { return v.Id }
+
+	return &UsersIterator{pager: newCursorPager(o.After, fetch, id)}
+}
+
+// ListAllUsers calls fn for every user matching opts, following all pages
+// fetched with api.List.
+// Iteration stops at the first error returned by fn or the API.
+// If ctx is canceled, ctx.Err() is returned.
+func ListAllUsers(ctx context.Context, api UsersAPI, opts *UsersListOptions, fn func(User) error) error {
+	return forEach(ctx, NewUsersIterator(api, opts).pager, fn)
+}
+
+// Next advances the iterator to the next user, fetching the next page
+// if required. It returns false when there are no more users or an error
+// occurred. Check Err after Next returned false.
+func (it *UsersIterator) Next(ctx context.Context) bool {
+	return it.pager.next(ctx)
+}
+
+// User returns the current user.
+// It is only valid after a call to Next returned true.
+func (it *UsersIterator) User() User {
+	return it.pager.current
+}
+
+// Err returns the first error that occurred during iteration, if any.
+func (it *UsersIterator) Err() error {
+	return it.pager.err
+}
+
+// Get returns a single user.
+//
+// id represents the unique identifier for the user
+//
+// API docs: https://api-docs.incident.io/tag/Users-V2#operation/Users%20V2_Show
+func (s *UsersService) Get(ctx context.Context, id string) (*UserResponse, *Response, error) {
+	u := apiV2Path(fmt.Sprintf("users/%s", id))
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := &UserResponse{}
+	resp, err := s.client.Do(ctx, req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// GetByEmail returns the user with the given email address,
+// compared case-insensitively.
+// ErrUserNotFound is returned if there is no such user.
+// An error is returned without calling the API, if email is empty.
+func (s *UsersService) GetByEmail(ctx context.Context, email string) (*UserResponse, *Response, error) {
+	if email == "" {
+		return nil, nil, errors.New("email must be set")
+	}
+	return s.getBy(ctx, &UsersListOptions{Email: email}, func(u User) bool {
+		return strings.EqualFold(u.Email, email)
+	})
+}
+
+// GetBySlackUserID returns the user with the given Slack user ID.
+// ErrUserNotFound is returned if there is no such user.
+// An error is returned without calling the API, if slackUserID is empty.
+func (s *UsersService) GetBySlackUserID(ctx context.Context, slackUserID string) (*UserResponse, *Response, error) {
+	if slackUserID == "" {
+		return nil, nil, errors.New("slack user ID must be set")
+	}
+	return s.getBy(ctx, &UsersListOptions{SlackUserID: slackUserID}, func(u User) bool {
+		return u.SlackUserID == slackUserID
+	})
+}
+
+// getBy returns the first user matching the filter of opts.
+// The user is checked with match, so a filter ignored by the API
+// doesn't return an unrelated user.
+func (s *UsersService) getBy(ctx context.Context, opts *UsersListOptions, match func(User) bool) (*UserResponse, *Response, error) {
+	opts.PageSize = 1
+
+	list, resp, err := s.List(ctx, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(list.Users) == 0 || !match(list.Users[0]) {
+		return nil, resp, ErrUserNotFound
+	}
+
+	return &UserResponse{User: list.Users[0]}, resp, nil
+}
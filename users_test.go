@@ -0,0 +1,72 @@
+package incident
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestUsersService_GetBy(t *testing.T) {
+	// The server ignores the filters and always returns the same user.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"users":[{"id":"u1","email":"Jane@example.com","slack_user_id":"U123"}]}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		get     func() (*UserResponse, *Response, error)
+		wantErr error
+	}{
+		{name: "email", get: func() (*UserResponse, *Response, error) { return c.Users.GetByEmail(ctx, "Jane@example.com") }},
+		{name: "email with different case", get: func() (*UserResponse, *Response, error) { return c.Users.GetByEmail(ctx, "jane@EXAMPLE.com") }},
+		{name: "other email", get: func() (*UserResponse, *Response, error) { return c.Users.GetByEmail(ctx, "john@example.com") }, wantErr: ErrUserNotFound},
+		{name: "Slack user ID", get: func() (*UserResponse, *Response, error) { return c.Users.GetBySlackUserID(ctx, "U123") }},
+		{name: "other Slack user ID", get: func() (*UserResponse, *Response, error) { return c.Users.GetBySlackUserID(ctx, "U999") }, wantErr: ErrUserNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := tt.get()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("returned error %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.User.Id != "u1" {
+				t.Errorf("returned user %q, want u1", got.User.Id)
+			}
+		})
+	}
+}
+
+func TestUsersService_GetBy_Empty(t *testing.T) {
+	// Without a value, the API would ignore the filter and return any user.
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"users":[{"id":"u1","email":"","slack_user_id":""}]}`)
+	}))
+	defer srv.Close()
+
+	c := NewClient("key", srv.Client())
+	c.BaseURL, _ = url.Parse(srv.URL + "/v1/")
+	ctx := context.Background()
+
+	if _, _, err := c.Users.GetByEmail(ctx, ""); err == nil {
+		t.Error("GetByEmail returned no error for an empty email")
+	}
+	if _, _, err := c.Users.GetBySlackUserID(ctx, ""); err == nil {
+		t.Error("GetBySlackUserID returned no error for an empty Slack user ID")
+	}
+	if requests != 0 {
+		t.Errorf("sent %d requests, want none", requests)
+	}
+}
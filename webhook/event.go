@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andygrunwald/go-incident"
+)
+
+// Event types sent by incident.io
+const (
+	EventTypeIncidentCreated = "public_incident.incident_created_v2"
+	EventTypeIncidentUpdated = "public_incident.incident_updated_v2"
+	EventTypeActionCreated   = "public_incident.action_created_v1"
+	EventTypeActionUpdated   = "public_incident.action_updated_v1"
+	EventTypeFollowUpCreated = "public_incident.follow_up_created_v1"
+	EventTypeFollowUpUpdated = "public_incident.follow_up_updated_v1"
+)
+
+// Event is a webhook event sent by incident.io.
+// Depending on Type, one of Incident, Action or FollowUp is set.
+type Event struct {
+	// Unique identifier of the message, from the webhook-id header.
+	// Retries of a message share the same ID.
+	ID string
+
+	// When the message was sent, from the webhook-timestamp header
+	Timestamp time.Time
+
+	// Type of the event, e.g. EventTypeIncidentCreated
+	Type string
+
+	// Incident the event is about.
+	// Set for EventTypeIncidentCreated and EventTypeIncidentUpdated.
+	Incident *incident.Incident
+
+	// Action the event is about.
+	// Set for EventTypeActionCreated and EventTypeActionUpdated.
+	Action *incident.Action
+
+	// Follow-up the event is about.
+	// Set for EventTypeFollowUpCreated and EventTypeFollowUpUpdated.
+	FollowUp *incident.FollowUp
+
+	// Raw payload of the event, e.g. to decode events that are
+	// not supported by this package.
+	Payload json.RawMessage
+}
+
+// ParseEvent decodes the body of a webhook into an Event.
+// Events of an unknown type are returned with only Type and Payload set.
+// The signature of the body should be checked with Verify before.
+func ParseEvent(body []byte) (*Event, error) {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("webhook: decoding event: %w", err)
+	}
+
+	e := &Event{Payload: json.RawMessage(body)}
+	if err := json.Unmarshal(envelope["event_type"], &e.Type); err != nil || e.Type == "" {
+		return nil, errors.New("webhook: decoding event: missing event_type")
+	}
+
+	// The object of the event is keyed by the event type.
+	var v interface{}
+	switch e.Type {
+	case EventTypeIncidentCreated, EventTypeIncidentUpdated:
+		e.Incident = &incident.Incident{}
+		v = e.Incident
+	case EventTypeActionCreated, EventTypeActionUpdated:
+		e.Action = &incident.Action{}
+		v = e.Action
+	case EventTypeFollowUpCreated, EventTypeFollowUpUpdated:
+		e.FollowUp = &incident.FollowUp{}
+		v = e.FollowUp
+	default:
+		return e, nil
+	}
+
+	data, ok := envelope[e.Type]
+	if !ok {
+		return nil, fmt.Errorf("webhook: decoding event: missing %q", e.Type)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, fmt.Errorf("webhook: decoding %s: %w", e.Type, err)
+	}
+	return e, nil
+}
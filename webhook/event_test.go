@@ -0,0 +1,64 @@
+package webhook
+
+import "testing"
+
+func TestParseEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		check   func(t *testing.T, e *Event)
+		wantErr bool
+	}{
+		{
+			name: "incident updated",
+			body: `{"event_type":"public_incident.incident_updated_v2","public_incident.incident_updated_v2":{"id":"inc_1","name":"Database is down"}}`,
+			check: func(t *testing.T, e *Event) {
+				if e.Incident == nil || e.Incident.Id != "inc_1" {
+					t.Errorf("Incident is %+v, want inc_1", e.Incident)
+				}
+			},
+		},
+		{
+			name: "action created",
+			body: `{"event_type":"public_incident.action_created_v1","public_incident.action_created_v1":{"id":"act_1"}}`,
+			check: func(t *testing.T, e *Event) {
+				if e.Action == nil || e.Action.Id != "act_1" || e.Incident != nil {
+					t.Errorf("Action is %+v, want act_1", e.Action)
+				}
+			},
+		},
+		{
+			name: "follow-up created",
+			body: `{"event_type":"public_incident.follow_up_created_v1","public_incident.follow_up_created_v1":{"id":"fu_1"}}`,
+			check: func(t *testing.T, e *Event) {
+				if e.FollowUp == nil || e.FollowUp.ID != "fu_1" {
+					t.Errorf("FollowUp is %+v, want fu_1", e.FollowUp)
+				}
+			},
+		},
+		{
+			name: "unknown type",
+			body: `{"event_type":"public_incident.something_new_v1"}`,
+			check: func(t *testing.T, e *Event) {
+				if e.Incident != nil || e.Action != nil || e.FollowUp != nil || len(e.Payload) == 0 {
+					t.Errorf("event is %+v, want only type and payload", e)
+				}
+			},
+		},
+		{name: "missing event type", body: `{}`, wantErr: true},
+		{name: "missing object", body: `{"event_type":"public_incident.incident_created_v2"}`, wantErr: true},
+		{name: "malformed object", body: `{"event_type":"public_incident.incident_created_v2","public_incident.incident_created_v2":[]}`, wantErr: true},
+		{name: "not JSON", body: `incident`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := ParseEvent([]byte(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseEvent returned error %v, want error %v", err, tt.wantErr)
+			}
+			if tt.check != nil {
+				tt.check(t, e)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Package webhook receives webhooks sent by incident.io.
+//
+// Handler verifies the signature of incoming webhooks, decodes them into
+// typed events and dispatches them to callbacks:
+//
+//	h := &webhook.Handler{
+//		Secret: "whsec_...",
+//		OnIncidentCreated: func(ctx context.Context, e *webhook.Event) error {
+//			log.Printf("incident %s created", e.Incident.Reference)
+//			return nil
+//		},
+//	}
+//	http.Handle("/webhooks/incident-io", h)
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxBodySize limits the size of a webhook body read by Handler.
+const maxBodySize = 5 << 20
+
+// EventFunc handles a webhook event.
+// Returning an error makes incident.io deliver the event again later.
+type EventFunc func(ctx context.Context, e *Event) error
+
+// Handler is a http.Handler receiving webhooks sent by incident.io.
+//
+// Requests with an invalid signature are rejected with 401 Unauthorized.
+// If Secret is empty or malformed, every request is rejected with
+// 500 Internal Server Error.
+// If the callback for an event returns an error, the handler responds with
+// 500 Internal Server Error, so that incident.io retries the delivery.
+type Handler struct {
+	// Signing secret of the webhook endpoint, as shown in the incident.io dashboard.
+	Secret string
+
+	// Maximum age of an accepted webhook. Defaults to DefaultTolerance.
+	Tolerance time.Duration
+
+	// Callbacks per event type. Events without callback are passed to OnEvent.
+	OnIncidentCreated EventFunc
+	OnIncidentUpdated EventFunc
+	OnActionCreated   EventFunc
+	OnActionUpdated   EventFunc
+	OnFollowUpCreated EventFunc
+	OnFollowUpUpdated EventFunc
+
+	// OnEvent is called for events without a dedicated callback,
+	// including event types unknown to this package.
+	OnEvent EventFunc
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Read one byte more than allowed, to tell an oversized body apart.
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		http.Error(w, "reading body failed", http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxBodySize {
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	tolerance := h.Tolerance
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+	if err := verify(h.Secret, r.Header, body, tolerance, time.Now()); err != nil {
+		if errors.Is(err, ErrInvalidSecret) {
+			// The secret itself is broken, which is not the fault of the sender.
+			// Don't reveal details about it.
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	e, err := ParseEvent(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	e.ID = headerValue(r.Header, "webhook-id", "svix-id")
+	e.Timestamp, _ = parseTimestamp(headerValue(r.Header, "webhook-timestamp", "svix-timestamp"))
+
+	if fn := h.callback(e.Type); fn != nil {
+		if err := fn(r.Context(), e); err != nil {
+			http.Error(w, "handling event failed", http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// callback returns the callback for events of type t, or nil.
+func (h *Handler) callback(t string) EventFunc {
+	var fn EventFunc
+	switch t {
+	case EventTypeIncidentCreated:
+		fn = h.OnIncidentCreated
+	case EventTypeIncidentUpdated:
+		fn = h.OnIncidentUpdated
+	case EventTypeActionCreated:
+		fn = h.OnActionCreated
+	case EventTypeActionUpdated:
+		fn = h.OnActionUpdated
+	case EventTypeFollowUpCreated:
+		fn = h.OnFollowUpCreated
+	case EventTypeFollowUpUpdated:
+		fn = h.OnFollowUpUpdated
+	}
+	if fn == nil {
+		fn = h.OnEvent
+	}
+	return fn
+}
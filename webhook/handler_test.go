@@ -0,0 +1,179 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newWebhookRequest returns a webhook request with body, signed with secret.
+func newWebhookRequest(t *testing.T, secret, body string) *http.Request {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	for k, v := range signedHeader(t, secret, time.Now(), body) {
+		r.Header[k] = v
+	}
+	return r
+}
+
+func TestHandler_Dispatch(t *testing.T) {
+	var got *Event
+	record := func(ctx context.Context, e *Event) error {
+		got = e
+		return nil
+	}
+	h := &Handler{
+		Secret:            testSecret,
+		OnIncidentCreated: record,
+	}
+
+	body := `{"event_type":"public_incident.incident_created_v2","public_incident.incident_created_v2":{"id":"inc_1","reference":"INC-1","name":"Database is down"}}`
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newWebhookRequest(t, testSecret, body))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	if got == nil {
+		t.Fatal("OnIncidentCreated was not called")
+	}
+	if got.Type != EventTypeIncidentCreated || got.Incident == nil || got.Incident.Reference != "INC-1" {
+		t.Errorf("event is %+v, want incident INC-1", got)
+	}
+	if got.ID != "msg_1" || got.Timestamp.IsZero() {
+		t.Errorf("event has ID %q and timestamp %v, want them from the headers", got.ID, got.Timestamp)
+	}
+}
+
+func TestHandler_UnknownEventType(t *testing.T) {
+	var called, fallback bool
+	h := &Handler{
+		Secret: testSecret,
+		OnIncidentCreated: func(ctx context.Context, e *Event) error {
+			called = true
+			return nil
+		},
+		OnEvent: func(ctx context.Context, e *Event) error {
+			fallback = true
+			if e.Type != "public_incident.something_new_v1" || len(e.Payload) == 0 {
+				t.Errorf("OnEvent got type %q with payload %s", e.Type, e.Payload)
+			}
+			return nil
+		},
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newWebhookRequest(t, testSecret, `{"event_type":"public_incident.something_new_v1","public_incident.something_new_v1":{}}`))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if called || !fallback {
+		t.Errorf("OnIncidentCreated called = %v, OnEvent called = %v, want only OnEvent", called, fallback)
+	}
+}
+
+func TestHandler_Status(t *testing.T) {
+	failing := func(ctx context.Context, e *Event) error {
+		return errors.New("database unavailable")
+	}
+
+	tests := []struct {
+		name    string
+		handler *Handler
+		request func(t *testing.T) *http.Request
+		want    int
+	}{
+		{
+			name:    "callback error",
+			handler: &Handler{Secret: testSecret, OnIncidentCreated: failing},
+			request: func(t *testing.T) *http.Request { return newWebhookRequest(t, testSecret, testBody) },
+			want:    http.StatusInternalServerError,
+		},
+		{
+			name:    "no callback",
+			handler: &Handler{Secret: testSecret},
+			request: func(t *testing.T) *http.Request { return newWebhookRequest(t, testSecret, testBody) },
+			want:    http.StatusOK,
+		},
+		{
+			name:    "invalid signature",
+			handler: &Handler{Secret: testSecret, OnIncidentCreated: failing},
+			request: func(t *testing.T) *http.Request { return newWebhookRequest(t, otherSecret, testBody) },
+			want:    http.StatusUnauthorized,
+		},
+		{
+			name:    "missing signature",
+			handler: &Handler{Secret: testSecret, OnIncidentCreated: failing},
+			request: func(t *testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(testBody))
+			},
+			want: http.StatusUnauthorized,
+		},
+		{
+			name:    "empty secret",
+			handler: &Handler{OnIncidentCreated: failing},
+			request: func(t *testing.T) *http.Request { return forgedRequest(testBody) },
+			want:    http.StatusInternalServerError,
+		},
+		{
+			name:    "malformed body",
+			handler: &Handler{Secret: testSecret, OnIncidentCreated: failing},
+			request: func(t *testing.T) *http.Request { return newWebhookRequest(t, testSecret, `{"event_type":`) },
+			want:    http.StatusBadRequest,
+		},
+		{
+			name:    "oversized body",
+			handler: &Handler{Secret: testSecret, OnIncidentCreated: failing},
+			request: func(t *testing.T) *http.Request {
+				return newWebhookRequest(t, testSecret, strings.Repeat(" ", maxBodySize+1))
+			},
+			want: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:    "body of maximum size",
+			handler: &Handler{Secret: testSecret},
+			request: func(t *testing.T) *http.Request {
+				return newWebhookRequest(t, testSecret, testBody+strings.Repeat(" ", maxBodySize-len(testBody)))
+			},
+			want: http.StatusOK,
+		},
+		{
+			name:    "wrong method",
+			handler: &Handler{Secret: testSecret, OnIncidentCreated: failing},
+			request: func(t *testing.T) *http.Request { return httptest.NewRequest(http.MethodGet, "/webhook", nil) },
+			want:    http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			tt.handler.ServeHTTP(w, tt.request(t))
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+		})
+	}
+}
+
+// forgedRequest returns a webhook request signed with an empty key,
+// as an attacker would sign it for a receiver without secret.
+func forgedRequest(body string) *http.Request {
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, nil)
+	mac.Write([]byte("msg_1." + ts + "." + body))
+
+	r := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	r.Header.Set("webhook-id", "msg_1")
+	r.Header.Set("webhook-timestamp", ts)
+	r.Header.Set("webhook-signature", "v1,"+base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	return r
+}
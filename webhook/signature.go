@@ -0,0 +1,154 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTolerance is the maximum age of a webhook accepted by Verify.
+// Older webhooks are rejected to prevent replay attacks.
+const DefaultTolerance = 5 * time.Minute
+
+// secretPrefix is the prefix of the signing secret shown in the
+// incident.io dashboard. The remainder is the base64 encoded key.
+const secretPrefix = "whsec_"
+
+var (
+	// ErrMissingHeaders is returned if a request lacks the signature headers.
+	ErrMissingHeaders = errors.New("webhook: missing signature headers")
+
+	// ErrInvalidTimestamp is returned if the timestamp of a webhook is
+	// malformed or outside of the tolerance.
+	ErrInvalidTimestamp = errors.New("webhook: invalid timestamp")
+
+	// ErrInvalidSignature is returned if no signature of a webhook matches.
+	ErrInvalidSignature = errors.New("webhook: invalid signature")
+
+	// ErrInvalidSecret is returned if the signing secret is empty or not
+	// of the form "whsec_<base64>". No webhook is accepted with such a secret.
+	ErrInvalidSecret = errors.New("webhook: invalid signing secret")
+)
+
+// Verify verifies the signature of a webhook sent by incident.io, using the
+// signing secret of the webhook endpoint and the tolerance DefaultTolerance.
+// An empty or malformed secret fails with ErrInvalidSecret.
+//
+// incident.io signs webhooks following the Standard Webhooks specification,
+// also known as Svix. The headers webhook-id, webhook-timestamp and
+// webhook-signature are used, falling back to their svix- prefixed variants.
+//
+// API docs: https://api-docs.incident.io/tag/Webhooks
+func Verify(secret string, header http.Header, body []byte) error {
+	return verify(secret, header, body, DefaultTolerance, time.Now())
+}
+
+func verify(secret string, header http.Header, body []byte, tolerance time.Duration, now time.Time) error {
+	// Check the secret first, so that a misconfigured receiver
+	// rejects every webhook, no matter what the sender did.
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return err
+	}
+
+	id := headerValue(header, "webhook-id", "svix-id")
+	timestamp := headerValue(header, "webhook-timestamp", "svix-timestamp")
+	signatures := headerValue(header, "webhook-signature", "svix-signature")
+	if id == "" || timestamp == "" || signatures == "" {
+		return ErrMissingHeaders
+	}
+
+	ts, err := parseTimestamp(timestamp)
+	if err != nil {
+		return err
+	}
+	if d := now.Sub(ts); d > tolerance || d < -tolerance {
+		return ErrInvalidTimestamp
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id + "." + timestamp + "."))
+	mac.Write(body)
+	expected := mac.Sum(nil)
+
+	// The header may contain several space separated signatures,
+	// e.g. while the secret is rotated. One matching signature is enough.
+	for _, s := range strings.Fields(signatures) {
+		version, sig, ok := strings.Cut(s, ",")
+		if !ok || version != "v1" {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(sig)
+		if err != nil {
+			continue
+		}
+		if hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// Sign returns the value of the webhook-signature header for the given
+// message. It is useful to send signed webhooks in tests.
+func Sign(secret, id string, timestamp time.Time, body []byte) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(id + "." + strconv.FormatInt(timestamp.Unix(), 10) + "."))
+	mac.Write(body)
+	return "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// decodeSecret returns the signing key of a secret like "whsec_<base64>".
+// An empty key is rejected, as anyone could sign webhooks with it.
+func decodeSecret(secret string) ([]byte, error) {
+	encoded, ok := cutPrefix(secret, secretPrefix)
+	if !ok {
+		return nil, fmt.Errorf("%w: missing %q prefix", ErrInvalidSecret, secretPrefix)
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSecret, err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("%w: empty key", ErrInvalidSecret)
+	}
+	return key, nil
+}
+
+// cutPrefix returns s without prefix and whether s started with prefix.
+func cutPrefix(s, prefix string) (string, bool) {
+	if !strings.HasPrefix(s, prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
+
+// parseTimestamp parses the unix timestamp of the webhook-timestamp header.
+func parseTimestamp(v string) (time.Time, error) {
+	secs, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return time.Time{}, ErrInvalidTimestamp
+	}
+	return time.Unix(secs, 0), nil
+}
+
+// headerValue returns the value of the first header of names that is set.
+func headerValue(header http.Header, names ...string) string {
+	for _, n := range names {
+		if v := header.Get(n); v != "" {
+			return v
+		}
+	}
+	return ""
+}
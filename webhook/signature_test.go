@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// testSecret is a signing secret with the key "test-signing-key".
+const testSecret = "whsec_dGVzdC1zaWduaW5nLWtleQ=="
+
+// otherSecret is a signing secret with the key "other-signing-key".
+const otherSecret = "whsec_b3RoZXItc2lnbmluZy1rZXk="
+
+const testBody = `{"event_type":"public_incident.incident_created_v2","public_incident.incident_created_v2":{"id":"inc_1"}}`
+
+// signedHeader returns the headers of a webhook signed with secret.
+func signedHeader(t *testing.T, secret string, ts time.Time, body string) http.Header {
+	t.Helper()
+	sig, err := Sign(secret, "msg_1", ts, []byte(body))
+	if err != nil {
+		t.Fatalf("Sign returned error: %v", err)
+	}
+	return http.Header{
+		"Webhook-Id":        {"msg_1"},
+		"Webhook-Timestamp": {strconv.FormatInt(ts.Unix(), 10)},
+		"Webhook-Signature": {sig},
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Now()
+
+	valid := signedHeader(t, testSecret, now, testBody)
+	other := signedHeader(t, otherSecret, now, testBody)
+
+	svix := http.Header{
+		"Svix-Id":        valid["Webhook-Id"],
+		"Svix-Timestamp": valid["Webhook-Timestamp"],
+		"Svix-Signature": valid["Webhook-Signature"],
+	}
+
+	rotated := valid.Clone()
+	rotated.Set("Webhook-Signature", "v1,bm90LWEtc2lnbmF0dXJl "+other.Get("Webhook-Signature")+" "+valid.Get("Webhook-Signature"))
+
+	unknownVersion := valid.Clone()
+	unknownVersion.Set("Webhook-Signature", "v2,"+valid.Get("Webhook-Signature")[len("v1,"):])
+
+	onlyInvalid := valid.Clone()
+	onlyInvalid.Set("Webhook-Signature", other.Get("Webhook-Signature")+" v1,%%%")
+
+	missing := valid.Clone()
+	missing.Del("Webhook-Id")
+
+	malformedTimestamp := valid.Clone()
+	malformedTimestamp.Set("Webhook-Timestamp", "yesterday")
+
+	tests := []struct {
+		name    string
+		secret  string
+		header  http.Header
+		body    string
+		wantErr error
+	}{
+		{name: "valid signature", secret: testSecret, header: valid, body: testBody},
+		{name: "tampered body", secret: testSecret, header: valid, body: `{"event_type":"public_incident.incident_updated_v2"}`, wantErr: ErrInvalidSignature},
+		{name: "other secret", secret: otherSecret, header: valid, body: testBody, wantErr: ErrInvalidSignature},
+		{name: "svix headers", secret: testSecret, header: svix, body: testBody},
+		{name: "space separated signatures", secret: testSecret, header: rotated, body: testBody},
+		{name: "unknown signature version", secret: testSecret, header: unknownVersion, body: testBody, wantErr: ErrInvalidSignature},
+		{name: "only invalid signatures", secret: testSecret, header: onlyInvalid, body: testBody, wantErr: ErrInvalidSignature},
+		{name: "missing header", secret: testSecret, header: missing, body: testBody, wantErr: ErrMissingHeaders},
+		{name: "malformed timestamp", secret: testSecret, header: malformedTimestamp, body: testBody, wantErr: ErrInvalidTimestamp},
+		{name: "empty secret", secret: "", header: valid, body: testBody, wantErr: ErrInvalidSecret},
+		{name: "secret without key", secret: "whsec_", header: valid, body: testBody, wantErr: ErrInvalidSecret},
+		{name: "secret without prefix", secret: "dGVzdC1zaWduaW5nLWtleQ==", header: valid, body: testBody, wantErr: ErrInvalidSecret},
+		{name: "secret not base64", secret: "whsec_%%%", header: valid, body: testBody, wantErr: ErrInvalidSecret},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify(tt.secret, tt.header, []byte(tt.body))
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("Verify returned %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerify_Tolerance(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		sent    time.Time
+		wantErr error
+	}{
+		{name: "just sent", sent: now},
+		{name: "within tolerance", sent: now.Add(-4 * time.Minute)},
+		{name: "too old", sent: now.Add(-6 * time.Minute), wantErr: ErrInvalidTimestamp},
+		{name: "too far in the future", sent: now.Add(6 * time.Minute), wantErr: ErrInvalidTimestamp},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := signedHeader(t, testSecret, tt.sent, testBody)
+			err := verify(testSecret, header, []byte(testBody), 5*time.Minute, now)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("verify returned %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSign_InvalidSecret(t *testing.T) {
+	for _, secret := range []string{"", "whsec_", "no-prefix"} {
+		if _, err := Sign(secret, "msg_1", time.Now(), []byte(testBody)); !errors.Is(err, ErrInvalidSecret) {
+			t.Errorf("Sign(%q) returned %v, want %v", secret, err, ErrInvalidSecret)
+		}
+	}
+}